@@ -14,11 +14,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-10-01/autoscalesettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/appserviceplans"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
 	webValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -42,20 +44,38 @@ const (
 )
 
 type ServicePlanModel struct {
-	Name                        string            `tfschema:"name"`
-	ResourceGroup               string            `tfschema:"resource_group_name"`
-	Location                    string            `tfschema:"location"`
-	Kind                        string            `tfschema:"kind"`
-	OSType                      OSType            `tfschema:"os_type"`
-	Sku                         string            `tfschema:"sku_name"`
-	AppServiceEnvironmentId     string            `tfschema:"app_service_environment_id"`
-	PerSiteScaling              bool              `tfschema:"per_site_scaling_enabled"`
-	Reserved                    bool              `tfschema:"reserved"`
-	WorkerCount                 int64             `tfschema:"worker_count"`
-	PremiumPlanAutoScaleEnabled bool              `tfschema:"premium_plan_auto_scale_enabled"`
-	MaximumElasticWorkerCount   int64             `tfschema:"maximum_elastic_worker_count"`
-	ZoneBalancing               bool              `tfschema:"zone_balancing_enabled"`
-	Tags                        map[string]string `tfschema:"tags"`
+	Name                        string                      `tfschema:"name"`
+	ResourceGroup               string                      `tfschema:"resource_group_name"`
+	Location                    string                      `tfschema:"location"`
+	Kind                        string                      `tfschema:"kind"`
+	OSType                      OSType                      `tfschema:"os_type"`
+	Sku                         string                      `tfschema:"sku_name"`
+	AppServiceEnvironmentId     string                      `tfschema:"app_service_environment_id"`
+	PerSiteScaling              bool                        `tfschema:"per_site_scaling_enabled"`
+	Reserved                    bool                        `tfschema:"reserved"`
+	WorkerCount                 int64                       `tfschema:"worker_count"`
+	PremiumPlanAutoScaleEnabled bool                        `tfschema:"premium_plan_auto_scale_enabled"`
+	MaximumElasticWorkerCount   int64                       `tfschema:"maximum_elastic_worker_count"`
+	ZoneBalancing               bool                        `tfschema:"zone_balancing_enabled"`
+	Autoscale                   []ServicePlanAutoscaleModel `tfschema:"autoscale"`
+	Tags                        map[string]string           `tfschema:"tags"`
+}
+
+type ServicePlanAutoscaleModel struct {
+	MinimumCapacity int64                           `tfschema:"minimum_capacity"`
+	DefaultCapacity int64                           `tfschema:"default_capacity"`
+	MaximumCapacity int64                           `tfschema:"maximum_capacity"`
+	Rule            []ServicePlanAutoscaleRuleModel `tfschema:"rule"`
+}
+
+type ServicePlanAutoscaleRuleModel struct {
+	MetricName      string  `tfschema:"metric_name"`
+	MetricStatistic string  `tfschema:"statistic"`
+	Operator        string  `tfschema:"operator"`
+	Threshold       float64 `tfschema:"threshold"`
+	ScaleDirection  string  `tfschema:"direction"`
+	ScaleChange     int64   `tfschema:"scale_change"`
+	CooldownMinutes int64   `tfschema:"cooldown_minutes"`
 }
 
 func (r ServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
@@ -69,7 +89,16 @@ func (r ServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"resource_group_name": commonschema.ResourceGroupName(),
 
-		"location": commonschema.Location(),
+		// `location` is Optional+Computed because when `app_service_environment_id` is set it defaults
+		// to the App Service Environment's location, which may live in a different Resource Group (and
+		// even a different Subscription) than this Service Plan.
+		"location": func() *pluginsdk.Schema {
+			s := commonschema.Location()
+			s.Optional = true
+			s.Required = false
+			s.Computed = true
+			return s
+		}(),
 
 		"sku_name": {
 			Type:     pluginsdk.TypeString,
@@ -127,6 +156,101 @@ func (r ServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
 			Optional: true,
 		},
 
+		// Only supported on Premium/ElasticPremium SKUs - enforced in CustomizeDiff using
+		// `helpers.PlanIsPremium`/`PlanSupportsScaleOut` since the underlying autoscale setting is a
+		// separate ARM resource with no SKU restriction of its own.
+		"autoscale": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"minimum_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"default_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"maximum_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"rule": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"metric_name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"statistic": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Default:  string(autoscalesettings.MetricStatisticTypeAverage),
+									ValidateFunc: validation.StringInSlice([]string{
+										string(autoscalesettings.MetricStatisticTypeAverage),
+										string(autoscalesettings.MetricStatisticTypeMax),
+										string(autoscalesettings.MetricStatisticTypeMin),
+										string(autoscalesettings.MetricStatisticTypeSum),
+									}, false),
+								},
+
+								"operator": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(autoscalesettings.ComparisonOperationTypeGreaterThan),
+										string(autoscalesettings.ComparisonOperationTypeGreaterThanOrEqual),
+										string(autoscalesettings.ComparisonOperationTypeLessThan),
+										string(autoscalesettings.ComparisonOperationTypeLessThanOrEqual),
+									}, false),
+								},
+
+								"threshold": {
+									Type:     pluginsdk.TypeFloat,
+									Required: true,
+								},
+
+								"direction": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(autoscalesettings.ScaleDirectionIncrease),
+										string(autoscalesettings.ScaleDirectionDecrease),
+									}, false),
+								},
+
+								"scale_change": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"cooldown_minutes": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      5,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
 		"tags": tags.Schema(),
 	}
 }
@@ -186,17 +310,38 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 				Sku: &appserviceplans.SkuDescription{
 					Name: pointer.To(servicePlan.Sku),
 				},
-				Location: location.Normalize(servicePlan.Location),
-				Tags:     pointer.To(servicePlan.Tags),
+				Tags: pointer.To(servicePlan.Tags),
 			}
 
 			if servicePlan.AppServiceEnvironmentId != "" {
 				if !strings.HasPrefix(servicePlan.Sku, "I") {
 					return fmt.Errorf("App Service Environment based Service Plans can only be used with Isolated SKUs")
 				}
+
+				aseId, err := parse.AppServiceEnvironmentID(servicePlan.AppServiceEnvironmentId)
+				if err != nil {
+					return fmt.Errorf("parsing `app_service_environment_id`: %+v", err)
+				}
+
+				ase, err := metadata.Client.AppService.EnvironmentClient.Get(ctx, *aseId)
+				if err != nil || ase.Model == nil {
+					return fmt.Errorf("retrieving %s: %+v", aseId, err)
+				}
+
+				aseLocation := location.Normalize(ase.Model.Location)
+				if servicePlan.Location != "" && !strings.EqualFold(location.Normalize(servicePlan.Location), aseLocation) {
+					return fmt.Errorf("the `location` of %s (%q) does not match the `location` of the supplied App Service Environment (%q) - `location` can be omitted when `app_service_environment_id` is set", id, servicePlan.Location, aseLocation)
+				}
+
+				appServicePlan.Location = aseLocation
 				appServicePlan.Properties.HostingEnvironmentProfile = &appserviceplans.HostingEnvironmentProfile{
 					Id: pointer.To(servicePlan.AppServiceEnvironmentId),
 				}
+			} else {
+				if servicePlan.Location == "" {
+					return fmt.Errorf("`location` is required when `app_service_environment_id` is not set")
+				}
+				appServicePlan.Location = location.Normalize(servicePlan.Location)
 			}
 
 			if servicePlan.MaximumElasticWorkerCount > 0 {
@@ -213,6 +358,12 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if len(servicePlan.Autoscale) == 1 {
+				if err := createOrUpdateServicePlanAutoscaleSetting(ctx, metadata, id, servicePlan.Autoscale[0]); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -281,6 +432,12 @@ func (r ServicePlanResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			autoscale, err := readServicePlanAutoscaleSetting(ctx, metadata, *id)
+			if err != nil {
+				return fmt.Errorf("reconciling `autoscale` for %s: %+v", id, err)
+			}
+			state.Autoscale = autoscale
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -295,6 +452,10 @@ func (r ServicePlanResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := deleteServicePlanAutoscaleSetting(ctx, metadata, *id); err != nil {
+				return err
+			}
+
 			client := metadata.Client.AppService.ServicePlanClient
 			metadata.Logger.Infof("deleting %s", id)
 
@@ -366,11 +527,156 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
+			if metadata.ResourceData.HasChange("autoscale") {
+				if len(state.Autoscale) == 1 {
+					if err := createOrUpdateServicePlanAutoscaleSetting(ctx, metadata, *id, state.Autoscale[0]); err != nil {
+						return err
+					}
+				} else if err := deleteServicePlanAutoscaleSetting(ctx, metadata, *id); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
 }
 
+// servicePlanAutoscaleSettingName is derived from the Service Plan so it is stable across
+// Create/Update/Delete without requiring its own piece of user-supplied state.
+func servicePlanAutoscaleSettingName(id commonids.AppServicePlanId) string {
+	return fmt.Sprintf("%s-autoscale", id.ServerFarmName)
+}
+
+func createOrUpdateServicePlanAutoscaleSetting(ctx context.Context, metadata sdk.ResourceMetaData, planId commonids.AppServicePlanId, autoscale ServicePlanAutoscaleModel) error {
+	client := metadata.Client.Monitor.AutoscaleSettingsClient
+	id := autoscalesettings.NewAutoScaleSettingID(planId.SubscriptionId, planId.ResourceGroupName, servicePlanAutoscaleSettingName(planId))
+
+	rules := make([]autoscalesettings.ScaleRule, 0, len(autoscale.Rule))
+	for _, r := range autoscale.Rule {
+		rules = append(rules, autoscalesettings.ScaleRule{
+			MetricTrigger: autoscalesettings.MetricTrigger{
+				MetricName:        r.MetricName,
+				MetricResourceUri: planId.ID(),
+				TimeGrain:         "PT1M",
+				Statistic:         autoscalesettings.MetricStatisticType(r.MetricStatistic),
+				TimeWindow:        "PT10M",
+				TimeAggregation:   autoscalesettings.TimeAggregationTypeAverage,
+				Operator:          autoscalesettings.ComparisonOperationType(r.Operator),
+				Threshold:         r.Threshold,
+			},
+			ScaleAction: autoscalesettings.ScaleAction{
+				Direction: autoscalesettings.ScaleDirection(r.ScaleDirection),
+				Type:      autoscalesettings.ScaleTypeChangeCount,
+				Value:     pointer.To(fmt.Sprintf("%d", r.ScaleChange)),
+				Cooldown:  fmt.Sprintf("PT%dM", r.CooldownMinutes),
+			},
+		})
+	}
+
+	parameters := autoscalesettings.AutoscaleSettingResource{
+		Location: "global",
+		Properties: &autoscalesettings.AutoscaleSetting{
+			Enabled:           pointer.To(true),
+			TargetResourceUri: pointer.To(planId.ID()),
+			Profiles: []autoscalesettings.AutoscaleProfile{
+				{
+					Name: "default",
+					Capacity: autoscalesettings.ScaleCapacity{
+						Minimum: fmt.Sprintf("%d", autoscale.MinimumCapacity),
+						Maximum: fmt.Sprintf("%d", autoscale.MaximumCapacity),
+						Default: fmt.Sprintf("%d", autoscale.DefaultCapacity),
+					},
+					Rules: &rules,
+				},
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s for %s: %+v", id, planId, err)
+	}
+
+	return nil
+}
+
+func deleteServicePlanAutoscaleSetting(ctx context.Context, metadata sdk.ResourceMetaData, planId commonids.AppServicePlanId) error {
+	client := metadata.Client.Monitor.AutoscaleSettingsClient
+	id := autoscalesettings.NewAutoScaleSettingID(planId.SubscriptionId, planId.ResourceGroupName, servicePlanAutoscaleSettingName(planId))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if _, err := client.Delete(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func readServicePlanAutoscaleSetting(ctx context.Context, metadata sdk.ResourceMetaData, planId commonids.AppServicePlanId) ([]ServicePlanAutoscaleModel, error) {
+	client := metadata.Client.Monitor.AutoscaleSettingsClient
+	id := autoscalesettings.NewAutoScaleSettingID(planId.SubscriptionId, planId.ResourceGroupName, servicePlanAutoscaleSettingName(planId))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.Model == nil || existing.Model.Properties == nil || len(existing.Model.Properties.Profiles) == 0 {
+		return nil, nil
+	}
+	profile := existing.Model.Properties.Profiles[0]
+
+	result := ServicePlanAutoscaleModel{
+		MinimumCapacity: parseInt64(profile.Capacity.Minimum),
+		DefaultCapacity: parseInt64(profile.Capacity.Default),
+		MaximumCapacity: parseInt64(profile.Capacity.Maximum),
+	}
+
+	if profile.Rules != nil {
+		for _, rule := range *profile.Rules {
+			result.Rule = append(result.Rule, ServicePlanAutoscaleRuleModel{
+				MetricName:      rule.MetricTrigger.MetricName,
+				MetricStatistic: string(rule.MetricTrigger.Statistic),
+				Operator:        string(rule.MetricTrigger.Operator),
+				Threshold:       rule.MetricTrigger.Threshold,
+				ScaleDirection:  string(rule.ScaleAction.Direction),
+				ScaleChange:     parseInt64(pointer.From(rule.ScaleAction.Value)),
+				CooldownMinutes: parseCooldownMinutes(rule.ScaleAction.Cooldown),
+			})
+		}
+	}
+
+	return []ServicePlanAutoscaleModel{result}, nil
+}
+
+// parseInt64 reads back the capacity/value strings the Autoscale Settings API represents as
+// strings; a malformed value (which shouldn't happen for settings this resource wrote) reads as 0.
+func parseInt64(input string) int64 {
+	var result int64
+	if _, err := fmt.Sscanf(input, "%d", &result); err != nil {
+		return 0
+	}
+	return result
+}
+
+func parseCooldownMinutes(cooldown string) int64 {
+	var minutes int64
+	if _, err := fmt.Sscanf(cooldown, "PT%dM", &minutes); err != nil {
+		return 0
+	}
+	return minutes
+}
+
 func (r ServicePlanResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -407,6 +713,12 @@ func (r ServicePlanResource) CustomizeDiff() sdk.ResourceFunc {
 				}
 			}
 
+			if autoscale := rd.Get("autoscale").([]interface{}); len(autoscale) > 0 {
+				if !helpers.PlanIsPremium(servicePlanSku) && !helpers.PlanSupportsScaleOut(servicePlanSku) {
+					return fmt.Errorf("`autoscale` can only be specified for Premium or ElasticPremium Service Plans")
+				}
+			}
+
 			o, n := rd.GetChange("zone_balancing_enabled")
 			if o.(bool) != n.(bool) {
 				// Changing `zone_balancing_enabled` from `false` to `true` requires the capacity of the sku to be greater than `1`.
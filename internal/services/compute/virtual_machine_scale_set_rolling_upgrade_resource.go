@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachineScaleSetRollingUpgradeResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineScaleSetRollingUpgradeResource{}
+
+type VirtualMachineScaleSetRollingUpgradeRollingUpgradePolicyModel struct {
+	MaxBatchInstancePercent             int64  `tfschema:"max_batch_instance_percent"`
+	MaxUnhealthyInstancePercent         int64  `tfschema:"max_unhealthy_instance_percent"`
+	MaxUnhealthyUpgradedInstancePercent int64  `tfschema:"max_unhealthy_upgraded_instance_percent"`
+	PauseTimeBetweenBatches             string `tfschema:"pause_time_between_batches"`
+	EnableCrossZoneUpgrade              bool   `tfschema:"enable_cross_zone_upgrade"`
+	PrioritizeUnhealthyInstances        bool   `tfschema:"prioritize_unhealthy_instances"`
+}
+
+type VirtualMachineScaleSetRollingUpgradeModel struct {
+	VirtualMachineScaleSetId string                                                          `tfschema:"virtual_machine_scale_set_id"`
+	WaitForCompletion        bool                                                            `tfschema:"wait_for_completion"`
+	TriggerExtensionUpgrade  string                                                          `tfschema:"trigger_extension_upgrade"`
+	RollingUpgradePolicy     []VirtualMachineScaleSetRollingUpgradeRollingUpgradePolicyModel `tfschema:"rolling_upgrade_policy"`
+
+	RunningStatus string `tfschema:"running_status"`
+	Progress      string `tfschema:"progress"`
+	Error         string `tfschema:"error"`
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_scale_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineScaleSetID,
+		},
+
+		// Defaults to true so the common case ("upgrade, then wait until it's actually applied")
+		// works without extra config; set to false to fire-and-forget a long-running upgrade.
+		"wait_for_completion": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		// not ForceNew - changing this value (e.g. to a fresh timestamp) is what opts into another
+		// extension upgrade pass without having to touch `rolling_upgrade_policy` itself.
+		"trigger_extension_upgrade": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"rolling_upgrade_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"max_batch_instance_percent": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(5, 100),
+					},
+
+					"max_unhealthy_instance_percent": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(5, 100),
+					},
+
+					"max_unhealthy_upgraded_instance_percent": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      20,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+
+					"pause_time_between_batches": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "PT0S",
+						ValidateFunc: validation.ISO8601Duration,
+					},
+
+					"enable_cross_zone_upgrade": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					"prioritize_unhealthy_instances": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"running_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"progress": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) ModelObject() interface{} {
+	return &VirtualMachineScaleSetRollingUpgradeModel{}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) ResourceType() string {
+	return "azurerm_virtual_machine_scale_set_rolling_upgrade"
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateVirtualMachineScaleSetID
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model VirtualMachineScaleSetRollingUpgradeModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.VirtualMachineScaleSetsClient
+
+			id, err := commonids.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId)
+			if err != nil {
+				return err
+			}
+
+			if len(model.RollingUpgradePolicy) == 1 {
+				if err := patchVirtualMachineScaleSetRollingUpgradePolicy(ctx, client, *id, model.RollingUpgradePolicy[0]); err != nil {
+					return err
+				}
+			}
+
+			if model.WaitForCompletion {
+				if err := client.VirtualMachineScaleSetRollingUpgradesStartExtensionUpgradeThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("starting rolling upgrade for %s: %+v", id, err)
+				}
+			} else {
+				if _, err := client.VirtualMachineScaleSetRollingUpgradesStartExtensionUpgrade(ctx, *id); err != nil {
+					return fmt.Errorf("starting rolling upgrade for %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseVirtualMachineScaleSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineScaleSetRollingUpgradeModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			// `wait_for_completion` is free to change on its own without kicking off another pass -
+			// `trigger_extension_upgrade` is the explicit opt-in for that.
+			if !metadata.ResourceData.HasChange("trigger_extension_upgrade") {
+				return nil
+			}
+
+			client := metadata.Client.Compute.VirtualMachineScaleSetsClient
+
+			if model.WaitForCompletion {
+				if err := client.VirtualMachineScaleSetRollingUpgradesStartExtensionUpgradeThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("starting rolling upgrade for %s: %+v", id, err)
+				}
+			} else {
+				if _, err := client.VirtualMachineScaleSetRollingUpgradesStartExtensionUpgrade(ctx, *id); err != nil {
+					return fmt.Errorf("starting rolling upgrade for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseVirtualMachineScaleSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.VirtualMachineScaleSetsClient
+
+			status, err := client.VirtualMachineScaleSetRollingUpgradesGetLatest(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(status.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving latest rolling upgrade status for %s: %+v", id, err)
+			}
+
+			state := VirtualMachineScaleSetRollingUpgradeModel{
+				VirtualMachineScaleSetId: id.ID(),
+				WaitForCompletion:        metadata.ResourceData.Get("wait_for_completion").(bool),
+				TriggerExtensionUpgrade:  metadata.ResourceData.Get("trigger_extension_upgrade").(string),
+			}
+
+			if model := status.Model; model != nil && model.Properties != nil {
+				if runningStatus := model.Properties.RunningStatus; runningStatus != nil {
+					state.RunningStatus = string(pointer.From(runningStatus.Code))
+				}
+				if progress := model.Properties.Progress; progress != nil {
+					state.Progress = fmt.Sprintf("successful=%d, failed=%d, inProgress=%d, pending=%d",
+						pointer.From(progress.SuccessfulInstanceCount),
+						pointer.From(progress.FailedInstanceCount),
+						pointer.From(progress.InProgressInstanceCount),
+						pointer.From(progress.PendingInstanceCount))
+				}
+				if upgradeError := model.Properties.Error; upgradeError != nil {
+					state.Error = pointer.From(upgradeError.Message)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseVirtualMachineScaleSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.VirtualMachineScaleSetsClient
+
+			// Cancel errors when there's nothing in progress to cancel, and with `wait_for_completion`
+			// defaulting to `true` the upgrade has normally already finished by the time Delete runs -
+			// only call Cancel when a rollout is actually still in flight.
+			status, err := client.VirtualMachineScaleSetRollingUpgradesGetLatest(ctx, *id)
+			if err != nil && !response.WasNotFound(status.HttpResponse) {
+				return fmt.Errorf("retrieving latest rolling upgrade status for %s: %+v", id, err)
+			}
+
+			inProgress := false
+			if model := status.Model; model != nil && model.Properties != nil && model.Properties.RunningStatus != nil {
+				inProgress = pointer.From(model.Properties.RunningStatus.Code) == virtualmachinescalesets.RollingUpgradeStatusCodeRollingForward
+			}
+
+			if inProgress {
+				if err := client.VirtualMachineScaleSetRollingUpgradesCancelThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("cancelling rolling upgrade for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func patchVirtualMachineScaleSetRollingUpgradePolicy(ctx context.Context, client *virtualmachinescalesets.VirtualMachineScaleSetsClient, id commonids.VirtualMachineScaleSetId, policy VirtualMachineScaleSetRollingUpgradeRollingUpgradePolicyModel) error {
+	existing, err := client.Get(ctx, id, virtualmachinescalesets.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `model.properties` was nil", id)
+	}
+
+	payload := virtualmachinescalesets.VirtualMachineScaleSetUpdate{
+		Properties: &virtualmachinescalesets.VirtualMachineScaleSetUpdateProperties{
+			UpgradePolicy: &virtualmachinescalesets.UpgradePolicy{
+				RollingUpgradePolicy: &virtualmachinescalesets.RollingUpgradePolicy{
+					MaxBatchInstancePercent:             pointer.To(policy.MaxBatchInstancePercent),
+					MaxUnhealthyInstancePercent:         pointer.To(policy.MaxUnhealthyInstancePercent),
+					MaxUnhealthyUpgradedInstancePercent: pointer.To(policy.MaxUnhealthyUpgradedInstancePercent),
+					PauseTimeBetweenBatches:             pointer.To(policy.PauseTimeBetweenBatches),
+					EnableCrossZoneUpgrade:              pointer.To(policy.EnableCrossZoneUpgrade),
+					PrioritizeUnhealthyInstances:        pointer.To(policy.PrioritizeUnhealthyInstances),
+				},
+			},
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, id, payload, virtualmachinescalesets.DefaultUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("patching rolling upgrade policy on %s: %+v", id, err)
+	}
+
+	return nil
+}
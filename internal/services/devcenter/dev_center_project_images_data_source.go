@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/images"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DevCenterProjectImagesDataSource struct{}
+
+var _ sdk.DataSource = DevCenterProjectImagesDataSource{}
+
+type DevCenterProjectImagesDataSourceModel struct {
+	DevCenterProjectId string                       `tfschema:"dev_center_project_id"`
+	NamePrefix         string                       `tfschema:"name_prefix"`
+	Publisher          string                       `tfschema:"publisher"`
+	Offer              string                       `tfschema:"offer"`
+	Images             []DevCenterProjectImageModel `tfschema:"images"`
+}
+
+type DevCenterProjectImageModel struct {
+	Name                    string   `tfschema:"name"`
+	Id                      string   `tfschema:"id"`
+	Publisher               string   `tfschema:"publisher"`
+	Offer                   string   `tfschema:"offer"`
+	Sku                     string   `tfschema:"sku"`
+	OsType                  string   `tfschema:"os_type"`
+	HibernateSupportEnabled bool     `tfschema:"hibernate_support_enabled"`
+	RecommendedVMSizes      []string `tfschema:"recommended_vm_sizes"`
+}
+
+func (d DevCenterProjectImagesDataSource) ResourceType() string {
+	return "azurerm_dev_center_project_images"
+}
+
+func (d DevCenterProjectImagesDataSource) ModelObject() interface{} {
+	return &DevCenterProjectImagesDataSourceModel{}
+}
+
+func (d DevCenterProjectImagesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dev_center_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: images.ValidateProjectID,
+		},
+
+		"name_prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"publisher": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"offer": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d DevCenterProjectImagesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"images": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"publisher": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"offer": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sku": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"os_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"hibernate_support_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"recommended_vm_sizes": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d DevCenterProjectImagesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config DevCenterProjectImagesDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			client := metadata.Client.DevCenter.ImagesClient
+
+			projectId, err := images.ParseProjectID(config.DevCenterProjectId)
+			if err != nil {
+				return err
+			}
+
+			options := images.DefaultListByProjectOperationOptions()
+			if filter := buildDevCenterProjectImagesFilter(config); filter != "" {
+				options.Filter = pointer.To(filter)
+			}
+
+			resp, err := client.ListByProjectComplete(ctx, *projectId, options)
+			if err != nil {
+				return fmt.Errorf("listing images for %s: %+v", projectId, err)
+			}
+
+			state := DevCenterProjectImagesDataSourceModel{
+				DevCenterProjectId: projectId.ID(),
+				NamePrefix:         config.NamePrefix,
+				Publisher:          config.Publisher,
+				Offer:              config.Offer,
+			}
+
+			for _, item := range resp.Items {
+				state.Images = append(state.Images, flattenDevCenterProjectImage(item))
+			}
+
+			metadata.SetID(projectId)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (d DevCenterProjectImagesDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return images.ValidateProjectID
+}
+
+// buildDevCenterProjectImagesFilter translates the data source's filter arguments into a server-side
+// OData `$filter` expression, so catalogs with hundreds of gallery images aren't fetched and filtered
+// in memory on every read.
+func buildDevCenterProjectImagesFilter(config DevCenterProjectImagesDataSourceModel) string {
+	clauses := make([]string, 0)
+
+	if config.NamePrefix != "" {
+		clauses = append(clauses, fmt.Sprintf("startswith(name,'%s')", odataEscapeStringLiteral(config.NamePrefix)))
+	}
+	if config.Publisher != "" {
+		clauses = append(clauses, fmt.Sprintf("publisher eq '%s'", odataEscapeStringLiteral(config.Publisher)))
+	}
+	if config.Offer != "" {
+		clauses = append(clauses, fmt.Sprintf("offer eq '%s'", odataEscapeStringLiteral(config.Offer)))
+	}
+
+	return strings.Join(clauses, " and ")
+}
+
+// odataEscapeStringLiteral escapes a value for embedding in a single-quoted OData string literal by
+// doubling any literal `'`, per the OData string-literal escaping rule - without it a value such as
+// `contoso's-image` would terminate the literal early and produce an invalid `$filter`.
+func odataEscapeStringLiteral(input string) string {
+	return strings.ReplaceAll(input, "'", "''")
+}
+
+func flattenDevCenterProjectImage(input images.Image) DevCenterProjectImageModel {
+	model := DevCenterProjectImageModel{
+		Name: pointer.From(input.Name),
+		Id:   pointer.From(input.Id),
+	}
+
+	if props := input.Properties; props != nil {
+		model.Publisher = pointer.From(props.Publisher)
+		model.Offer = pointer.From(props.Offer)
+		model.Sku = pointer.From(props.Sku)
+		model.OsType = string(pointer.From(props.OsType))
+		model.HibernateSupportEnabled = pointer.From(props.HibernateSupport) == images.HibernateSupportEnabled
+		model.RecommendedVMSizes = pointer.From(props.RecommendedVMSizeSkus)
+	}
+
+	return model
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+)
+
+type DevCenterProjectImagesDataSource struct{}
+
+func TestAccDevCenterProjectImagesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_project_images", "test")
+	r := DevCenterProjectImagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				acceptance.TestCheckResourceAttrSet(data.ResourceName, "images.#"),
+			),
+		},
+	})
+}
+
+func (DevCenterProjectImagesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctestdc-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctestdcp-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dev_center_id       = azurerm_dev_center.test.id
+}
+
+data "azurerm_dev_center_project_images" "test" {
+  dev_center_project_id = azurerm_dev_center_project.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
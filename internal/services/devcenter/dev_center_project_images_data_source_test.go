@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import "testing"
+
+func TestBuildDevCenterProjectImagesFilter_escapesQuotes(t *testing.T) {
+	config := DevCenterProjectImagesDataSourceModel{
+		Publisher: "contoso's gallery",
+	}
+
+	expected := "publisher eq 'contoso''s gallery'"
+	if actual := buildDevCenterProjectImagesFilter(config); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package events publishes structured lifecycle events for long-running Monitor reconciliations (e.g.
+// `azurerm_monitor_diagnostic_setting`) so platform teams can observe rollouts that routinely take
+// minutes due to eventual consistency, without scraping Terraform logs.
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Phase identifies where a resource is in its reconciliation lifecycle.
+type Phase string
+
+const (
+	PhaseCreating Phase = "creating"
+	PhaseCreated  Phase = "created"
+	PhaseWaiting  Phase = "waiting"
+	PhaseReady    Phase = "ready"
+	PhaseDeleting Phase = "deleting"
+	PhaseDeleted  Phase = "deleted"
+	PhaseError    Phase = "error"
+)
+
+// Event is the structured payload emitted at each lifecycle transition.
+type Event struct {
+	ID               string `json:"id"`
+	TargetResourceID string `json:"target_resource_id"`
+	Name             string `json:"name"`
+	Phase            Phase  `json:"phase"`
+	Timestamp        string `json:"timestamp"`
+	Error            string `json:"error,omitempty"`
+}
+
+// Publisher is implemented by every event sink backend.
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// noopPublisher discards every event - it's the default sink until a real one is configured.
+type noopPublisher struct{}
+
+// NewNoopPublisher returns a Publisher that discards every event.
+func NewNoopPublisher() Publisher {
+	return noopPublisher{}
+}
+
+func (noopPublisher) Publish(_ context.Context, _ Event) error {
+	return nil
+}
+
+// httpPublisher POSTs each event as JSON to a webhook URL.
+type httpPublisher struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewHTTPPublisher returns a Publisher that POSTs each event as JSON to webhookURL.
+func NewHTTPPublisher(webhookURL string) Publisher {
+	return &httpPublisher{
+		webhookURL: webhookURL,
+		client:     http.DefaultClient,
+	}
+}
+
+func (p *httpPublisher) Publish(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshaling lifecycle event: %+v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building lifecycle event request: %+v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("publishing lifecycle event: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("publishing lifecycle event: webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// EventHubSender abstracts sending a single message to an Event Hub, so this package doesn't have to
+// pin a specific data-plane SDK for a backend most configurations won't use.
+type EventHubSender interface {
+	SendEvent(ctx context.Context, data []byte) error
+}
+
+// eventHubPublisher publishes each event as a single Event Hub message via sender.
+type eventHubPublisher struct {
+	sender EventHubSender
+}
+
+// NewEventHubPublisher returns a Publisher that forwards each event to sender.
+func NewEventHubPublisher(sender EventHubSender) Publisher {
+	return &eventHubPublisher{sender: sender}
+}
+
+func (p *eventHubPublisher) Publish(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshaling lifecycle event: %+v", err)
+	}
+
+	if err := p.sender.SendEvent(ctx, body); err != nil {
+		return fmt.Errorf("publishing lifecycle event: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// DiagnosticSettingV0ToV1 checks that the `id` of pre-fan-out `azurerm_monitor_diagnostic_setting`
+// state is a `{resourceId}|{name}` pair - the form MonitorDiagnosticSettingId.ID() produces for a
+// single target - and leaves it unchanged. A malformed `id` fails the upgrade; a resource scope that
+// isn't subscription-prefixed is passed through as-is.
+type DiagnosticSettingV0ToV1 struct{}
+
+func (DiagnosticSettingV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (DiagnosticSettingV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		oldId, ok := rawState["id"].(string)
+		if !ok || oldId == "" {
+			return rawState, nil
+		}
+
+		v := strings.SplitN(oldId, "|", 2)
+		if len(v) != 2 || v[0] == "" || v[1] == "" {
+			return nil, fmt.Errorf("expected the Monitor Diagnostics ID to be in the format `{resourceId}|{name}` but got %q", oldId)
+		}
+
+		if !strings.HasPrefix(v[0], "/subscriptions/") {
+			// a resource scope that isn't subscription-prefixed (e.g. a management-group-scoped Activity
+			// Log setting) is still a plausible `{resourceId}|{name}` pair - pass it through unchanged
+			// rather than hard-failing the upgrade over a shape this upgrader doesn't specifically handle.
+			log.Printf("[DEBUG] Monitor Diagnostic Setting %q has a non-subscription-prefixed resource scope - leaving it as-is", oldId)
+			return rawState, nil
+		}
+
+		// the pre-fan-out format is already `{resourceId}|{name}` - this is a validating no-op, not a
+		// rewrite, since that's also the canonical single-target encoding today.
+		log.Printf("[DEBUG] Monitor Diagnostic Setting %q is already in the canonical single-target format", oldId)
+
+		return rawState, nil
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDiagnosticSettingV0ToV1_LegacyIdUnchanged(t *testing.T) {
+	input := map[string]interface{}{
+		"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1|setting1",
+	}
+
+	actual, err := DiagnosticSettingV0ToV1{}.UpgradeFunc()(context.Background(), input, nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if actual["id"].(string) != input["id"].(string) {
+		t.Fatalf("expected %q but got %q", input["id"], actual["id"])
+	}
+}
+
+func TestDiagnosticSettingV0ToV1_MalformedId(t *testing.T) {
+	input := map[string]interface{}{
+		"id": "not-a-valid-id",
+	}
+
+	if _, err := (DiagnosticSettingV0ToV1{}).UpgradeFunc()(context.Background(), input, nil); err == nil {
+		t.Fatal("expected an error but didn't get one")
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -16,18 +18,65 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	authRuleParse "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategory"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/events"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+// monitorDiagnosticSettingEventPublisher is the lifecycle-event sink used when reconciling
+// azurerm_monitor_diagnostic_setting resources. It defaults to a noop; there's no `provider.go`
+// `features` block in this tree yet to hang a `monitor_events {}` block off of, so until that
+// schema exists there's no user-reachable, documented way to point this at a real backend -
+// SetMonitorDiagnosticSettingEventPublisher is exported for tests and for that future wiring,
+// not for configuration via environment variable.
+var monitorDiagnosticSettingEventPublisher events.Publisher = events.NewNoopPublisher()
+
+// SetMonitorDiagnosticSettingEventPublisher overrides the lifecycle-event sink used for every
+// subsequent azurerm_monitor_diagnostic_setting reconciliation.
+func SetMonitorDiagnosticSettingEventPublisher(publisher events.Publisher) {
+	if publisher != nil {
+		monitorDiagnosticSettingEventPublisher = publisher
+	}
+}
+
+func publishMonitorDiagnosticSettingEvent(ctx context.Context, targetId, targetResourceUri, name string, phase events.Phase, err error) {
+	event := events.Event{
+		ID:               targetId,
+		TargetResourceID: targetResourceUri,
+		Name:             name,
+		Phase:            phase,
+		Timestamp:        time.Now().UTC().Format(time.RFC3339),
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	if publishErr := monitorDiagnosticSettingEventPublisher.Publish(ctx, event); publishErr != nil {
+		log.Printf("[WARN] Monitor Diagnostic Setting: failed to publish %q lifecycle event for Resource %q: %+v", phase, targetResourceUri, publishErr)
+	}
+}
+
+// monitorDiagnosticSettingRefreshFuncWithHeartbeat wraps refresh so every poll of the `NotFound <->
+// Exists` state-change loop also emits a `waiting` lifecycle event.
+func monitorDiagnosticSettingRefreshFuncWithHeartbeat(ctx context.Context, targetId, targetResourceUri, name string, refresh pluginsdk.StateRefreshFunc) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		publishMonitorDiagnosticSettingEvent(ctx, targetId, targetResourceUri, name, events.PhaseWaiting, nil)
+		return refresh()
+	}
+}
+
 func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceMonitorDiagnosticSettingCreate,
@@ -36,8 +85,16 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 		Delete: resourceMonitorDiagnosticSettingDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := ParseMonitorDiagnosticId(id)
-			return err
+			_, errs := ValidateMonitorDiagnosticSettingID(id, "id")
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			return nil
+		}),
+
+		SchemaVersion: 1,
+		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.DiagnosticSettingV0ToV1{},
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -57,9 +114,28 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 
 			"target_resource_id": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: azure.ValidateResourceID,
+				ExactlyOneOf: []string{"target_resource_id", "target_resource_ids"},
+			},
+
+			"target_resource_ids": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"target_resource_id", "target_resource_ids"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"continue_on_error": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"eventhub_name": {
@@ -107,10 +183,24 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"enable_all_logs": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				AtLeastOneOf:  []string{"enabled_log", "enabled_metric", "enable_all_logs", "enable_all_metrics"},
+				ConflictsWith: []string{"enabled_log"},
+			},
+
+			"enable_all_metrics": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				AtLeastOneOf:  []string{"enabled_log", "enabled_metric", "enable_all_logs", "enable_all_metrics"},
+				ConflictsWith: []string{"enabled_metric"},
+			},
+
 			"enabled_log": {
 				Type:         pluginsdk.TypeSet,
 				Optional:     true,
-				AtLeastOneOf: []string{"enabled_log", "enabled_metric"},
+				AtLeastOneOf: []string{"enabled_log", "enabled_metric", "enable_all_logs", "enable_all_metrics"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -145,6 +235,36 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 								},
 							},
 						},
+
+						"workspace_table": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"retention_in_days": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.Any(validation.IntBetween(4, 730), validation.IntInSlice([]int{-1})),
+									},
+
+									"total_retention_in_days": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.Any(validation.IntBetween(4, 4383), validation.IntInSlice([]int{-1})),
+									},
+
+									"plan": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(tables.TablePlanEnumAnalytics),
+											string(tables.TablePlanEnumBasic),
+										}, false),
+									},
+								},
+							},
+						},
 					},
 				},
 				Set: resourceMonitorDiagnosticLogSettingHash,
@@ -153,12 +273,18 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			"enabled_metric": {
 				Type:         pluginsdk.TypeSet,
 				Optional:     true,
-				AtLeastOneOf: []string{"enabled_log", "enabled_metric"},
+				AtLeastOneOf: []string{"enabled_log", "enabled_metric", "enable_all_logs", "enable_all_metrics"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"category_group": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
@@ -168,25 +294,35 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 	}
 
 	if !features.FivePointOh() {
-		resource.Schema["enabled_log"].AtLeastOneOf = []string{"enabled_log", "enabled_metric", "metric"}
+		resource.Schema["enabled_log"].AtLeastOneOf = []string{"enabled_log", "enabled_metric", "metric", "enable_all_logs", "enable_all_metrics"}
 
-		resource.Schema["enabled_metric"].AtLeastOneOf = []string{"enabled_log", "enabled_metric", "metric"}
+		resource.Schema["enabled_metric"].AtLeastOneOf = []string{"enabled_log", "enabled_metric", "metric", "enable_all_logs", "enable_all_metrics"}
 		resource.Schema["enabled_metric"].Computed = true
 		resource.Schema["enabled_metric"].ConflictsWith = []string{"metric"}
 
+		resource.Schema["enable_all_logs"].AtLeastOneOf = []string{"enabled_log", "enabled_metric", "metric", "enable_all_logs", "enable_all_metrics"}
+		resource.Schema["enable_all_metrics"].AtLeastOneOf = []string{"enabled_log", "enabled_metric", "metric", "enable_all_logs", "enable_all_metrics"}
+		resource.Schema["enable_all_metrics"].ConflictsWith = []string{"enabled_metric", "metric"}
+
 		resource.Schema["metric"] = &pluginsdk.Schema{
 			Type:          pluginsdk.TypeSet,
 			Optional:      true,
 			Computed:      true,
 			Deprecated:    "`metric` has been deprecated in favor of the `enabled_metric` property and will be removed in v5.0 of the AzureRM provider",
-			AtLeastOneOf:  []string{"enabled_log", "enabled_metric", "metric"},
-			ConflictsWith: []string{"enabled_metric"},
+			AtLeastOneOf:  []string{"enabled_log", "enabled_metric", "metric", "enable_all_logs", "enable_all_metrics"},
+			ConflictsWith: []string{"enabled_metric", "enable_all_metrics"},
 			Set:           resourceMonitorDiagnosticMetricsSettingHash,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"category": {
 						Type:         pluginsdk.TypeString,
-						Required:     true,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"category_group": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
 
@@ -230,20 +366,53 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
 
-	id := diagnosticsettings.NewScopedDiagnosticSettingID(d.Get("target_resource_id").(string), d.Get("name").(string))
-	resourceId := fmt.Sprintf("%s|%s", id.ResourceUri, id.DiagnosticSettingName)
+	name := d.Get("name").(string)
+	targets := monitorDiagnosticSettingTargetResourceIds(d)
+	resourceId := MonitorDiagnosticSettingId{Name: name, TargetResourceUris: targets}.ID()
+
+	for _, targetResourceUri := range targets {
+		id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceUri, name)
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing Monitor Diagnostic Setting %q for Resource %q: %s", id.DiagnosticSettingName, id.ResourceUri, err)
+			}
+		}
 
-	existing, err := client.Get(ctx, id)
-	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
-			return fmt.Errorf("checking for presence of existing Monitor Diagnostic Setting %q for Resource %q: %s", id.DiagnosticSettingName, id.ResourceUri, err)
+			return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", resourceId)
 		}
 	}
 
-	if !response.WasNotFound(existing.HttpResponse) {
-		return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", resourceId)
+	continueOnError := d.Get("continue_on_error").(bool)
+	succeeded, applyErr := monitorDiagnosticSettingApplyToTargets(targets, continueOnError, func(targetResourceUri string) error {
+		return monitorDiagnosticSettingCreateOrUpdateTarget(ctx, meta, d, targetResourceUri)
+	})
+
+	// Track whatever succeeded even on failure, so a partial fan-out (with `continue_on_error =
+	// false`) leaves its already-created Diagnostic Settings visible and reconcilable in state
+	// instead of live in Azure but orphaned from Terraform.
+	if len(succeeded) > 0 {
+		d.SetId(MonitorDiagnosticSettingId{Name: name, TargetResourceUris: succeeded}.ID())
 	}
 
+	if applyErr != nil {
+		return applyErr
+	}
+
+	return resourceMonitorDiagnosticSettingRead(d, meta)
+}
+
+// monitorDiagnosticSettingCreateOrUpdateTarget writes the configured Diagnostic Setting to a single
+// target resource. It's shared between Create and Update (the underlying API call is an upsert) and is
+// the unit of work fanned out across `target_resource_ids` with bounded concurrency.
+func monitorDiagnosticSettingCreateOrUpdateTarget(ctx context.Context, meta interface{}, d *pluginsdk.ResourceData, targetResourceUri string) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	name := d.Get("name").(string)
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceUri, name)
+
+	publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseCreating, nil)
+
 	var logs []diagnosticsettings.LogSettings
 	hasEnabledLogs := false
 	if enabledLogs, ok := d.GetOk("enabled_log"); ok {
@@ -263,7 +432,11 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	hasEnabledMetrics := false
 
 	if !features.FivePointOh() {
-		metrics = expandMonitorDiagnosticsSettingsMetrics(d.Get("metric").(*pluginsdk.Set).List())
+		expandedMetrics, err := expandMonitorDiagnosticsSettingsMetrics(d.Get("metric").(*pluginsdk.Set).List())
+		if err != nil {
+			return fmt.Errorf("expanding metric: %+v", err)
+		}
+		metrics = expandedMetrics
 		for _, v := range metrics {
 			if v.Enabled {
 				hasEnabledMetrics = true
@@ -275,11 +448,32 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	if enabledMetrics, ok := d.GetOk("enabled_metric"); ok {
 		enabledMetricsList := enabledMetrics.(*pluginsdk.Set).List()
 		if len(enabledMetricsList) > 0 {
-			metrics = expandMonitorDiagnosticsSettingsEnabledMetrics(enabledMetricsList)
+			expandedEnabledMetrics, err := expandMonitorDiagnosticsSettingsEnabledMetrics(enabledMetricsList)
+			if err != nil {
+				return fmt.Errorf("expanding enabled_metric: %+v", err)
+			}
+			metrics = expandedEnabledMetrics
 			hasEnabledMetrics = true
 		}
 	}
 
+	if d.Get("enable_all_logs").(bool) || d.Get("enable_all_metrics").(bool) {
+		discoveredLogs, discoveredMetrics, err := discoverMonitorDiagnosticSettingCategories(ctx, meta, targetResourceUri)
+		if err != nil {
+			return fmt.Errorf("discovering Diagnostic Setting categories for Resource %q: %+v", targetResourceUri, err)
+		}
+
+		if d.Get("enable_all_logs").(bool) {
+			logs = discoveredLogs
+			hasEnabledLogs = len(logs) > 0
+		}
+
+		if d.Get("enable_all_metrics").(bool) {
+			metrics = discoveredMetrics
+			hasEnabledMetrics = len(metrics) > 0
+		}
+	}
+
 	if !hasEnabledMetrics && !hasEnabledLogs {
 		return fmt.Errorf("at least one type of Log or Metric must be enabled")
 	}
@@ -318,8 +512,11 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
-		return fmt.Errorf("creating Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+		err = fmt.Errorf("creating/updating Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+		publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseError, err)
+		return err
 	}
+	publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseCreated, nil)
 
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -331,33 +528,58 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:                   []string{"NotFound"},
 		Target:                    []string{"Exists"},
-		Refresh:                   monitorDiagnosticSettingRefreshFunc(ctx, client, id),
+		Refresh:                   monitorDiagnosticSettingRefreshFuncWithHeartbeat(ctx, id.ID(), targetResourceUri, name, monitorDiagnosticSettingRefreshFunc(ctx, client, id)),
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 3,
 		Timeout:                   time.Until(deadline),
 	}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Monitor Diagnostic Setting %q for Resource %q to become ready: %s", id.DiagnosticSettingName, id.ResourceUri, err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		err = fmt.Errorf("waiting for Monitor Diagnostic Setting %q for Resource %q to become ready: %s", id.DiagnosticSettingName, id.ResourceUri, err)
+		publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseError, err)
+		return err
 	}
+	publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseReady, nil)
 
-	d.SetId(resourceId)
+	if workspaceId != "" && d.Get("log_analytics_destination_type").(string) == "Dedicated" {
+		if enabledLogs, ok := d.GetOk("enabled_log"); ok {
+			if err := monitorDiagnosticSettingApplyWorkspaceTables(ctx, meta, workspaceId, enabledLogs.(*pluginsdk.Set).List()); err != nil {
+				return err
+			}
+		}
+	}
 
-	return resourceMonitorDiagnosticSettingRead(d, meta)
+	return nil
 }
 
 func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
 
-	id, err := ParseMonitorDiagnosticId(d.Id())
-	if err != nil {
+	targets := monitorDiagnosticSettingTargetResourceIds(d)
+	continueOnError := d.Get("continue_on_error").(bool)
+
+	if _, err := monitorDiagnosticSettingApplyToTargets(targets, continueOnError, func(targetResourceUri string) error {
+		return monitorDiagnosticSettingUpdateTarget(ctx, meta, d, targetResourceUri)
+	}); err != nil {
 		return err
 	}
 
-	existing, err := client.Get(ctx, *id)
+	return resourceMonitorDiagnosticSettingRead(d, meta)
+}
+
+// monitorDiagnosticSettingUpdateTarget applies the configured Diagnostic Setting to a single target
+// resource, diffing against that target's own remote state so a category removed from config is
+// explicitly disabled rather than left untouched.
+func monitorDiagnosticSettingUpdateTarget(ctx context.Context, meta interface{}, d *pluginsdk.ResourceData, targetResourceUri string) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	name := d.Get("name").(string)
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceUri, name)
+
+	publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseCreating, nil)
+
+	existing, err := client.Get(ctx, id)
 	if err != nil {
 		return fmt.Errorf("retrieving Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
 	}
@@ -401,7 +623,10 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 	if d.HasChange("enabled_metric") {
 		enabledMetrics := d.Get("enabled_metric").(*pluginsdk.Set).List()
 		if len(enabledMetrics) > 0 {
-			expandEnabledMetrics := expandMonitorDiagnosticsSettingsEnabledMetrics(enabledMetrics)
+			expandEnabledMetrics, err := expandMonitorDiagnosticsSettingsEnabledMetrics(enabledMetrics)
+			if err != nil {
+				return fmt.Errorf("expanding enabled_metric: %+v", err)
+			}
 			metrics = expandEnabledMetrics
 			hasEnabledMetrics = true
 		} else if existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.Metrics != nil {
@@ -423,7 +648,11 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 
 	if !features.FivePointOh() {
 		if d.HasChange("metric") {
-			metrics = expandMonitorDiagnosticsSettingsMetrics(d.Get("metric").(*pluginsdk.Set).List())
+			expandedMetrics, err := expandMonitorDiagnosticsSettingsMetrics(d.Get("metric").(*pluginsdk.Set).List())
+			if err != nil {
+				return fmt.Errorf("expanding metric: %+v", err)
+			}
+			metrics = expandedMetrics
 			for _, v := range metrics {
 				if v.Enabled {
 					hasEnabledMetrics = true
@@ -433,6 +662,25 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	// re-discover on every apply (rather than gating on d.HasChange) so that categories Azure adds
+	// to the resource provider after this setting was created get picked up automatically
+	if d.Get("enable_all_logs").(bool) || d.Get("enable_all_metrics").(bool) {
+		discoveredLogs, discoveredMetrics, err := discoverMonitorDiagnosticSettingCategories(ctx, meta, targetResourceUri)
+		if err != nil {
+			return fmt.Errorf("discovering Diagnostic Setting categories for Resource %q: %+v", targetResourceUri, err)
+		}
+
+		if d.Get("enable_all_logs").(bool) {
+			logs = discoveredLogs
+			hasEnabledLogs = len(logs) > 0
+		}
+
+		if d.Get("enable_all_metrics").(bool) {
+			metrics = discoveredMetrics
+			hasEnabledMetrics = len(metrics) > 0
+		}
+	}
+
 	// if no logs/metrics are enabled the API "creates" but 404's on Read
 	if !hasEnabledMetrics && !hasEnabledLogs {
 		return fmt.Errorf("at least one type of Log or Metric must be enabled")
@@ -471,10 +719,22 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.LogAnalyticsDestinationType = &v
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
-		return fmt.Errorf("updating Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		err = fmt.Errorf("updating Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+		publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseError, err)
+		return err
 	}
-	return resourceMonitorDiagnosticSettingRead(d, meta)
+	publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseReady, nil)
+
+	if workspaceId != "" && d.Get("log_analytics_destination_type").(string) == "Dedicated" {
+		if enabledLogs, ok := d.GetOk("enabled_log"); ok {
+			if err := monitorDiagnosticSettingApplyWorkspaceTables(ctx, meta, workspaceId, enabledLogs.(*pluginsdk.Set).List()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -482,28 +742,64 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseMonitorDiagnosticId(d.Id())
+	compositeId, err := ParseMonitorDiagnosticSettingID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id)
-	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
-			log.Printf("[WARN] Monitor Diagnostics Setting %q was not found for Resource %q - removing from state!", id.DiagnosticSettingName, id.ResourceUri)
-			d.SetId("")
-			return nil
+	// the settings are identical across every target by construction, so the first target that's
+	// still present is read in full; the rest only need a presence check
+	var resp diagnosticsettings.GetOperationResponse
+	found := false
+	missing := make([]string, 0)
+
+	for _, targetResourceUri := range compositeId.TargetResourceUris {
+		id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceUri, compositeId.Name)
+
+		targetResp, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(targetResp.HttpResponse) {
+				missing = append(missing, targetResourceUri)
+				continue
+			}
+
+			return fmt.Errorf("retrieving Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
 		}
 
-		return fmt.Errorf("retrieving Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+		if !found {
+			resp = targetResp
+			found = true
+		}
+	}
+
+	if len(missing) > 0 {
+		log.Printf("[WARN] Monitor Diagnostics Setting %q was not found for Resource(s) %q", compositeId.Name, strings.Join(missing, ", "))
 	}
 
-	d.Set("name", id.DiagnosticSettingName)
-	resourceUri := id.ResourceUri
-	if v, err := commonids.ParseKustoClusterIDInsensitively(resourceUri); err == nil {
-		resourceUri = v.ID()
+	if !found {
+		log.Printf("[WARN] Monitor Diagnostics Setting %q was not found for any target Resource - removing from state!", compositeId.Name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", compositeId.Name)
+
+	// `target_resource_ids` is the attribute actually configured whenever more than one target is
+	// present, but it's equally valid - per the schema's MinItems: 1 - for a user to configure it
+	// with a single element; inferring shape from list length alone would silently echo that back
+	// as `target_resource_id` instead, which the user never set, producing a perpetual diff. Honor
+	// whichever attribute is already present in state (i.e. was actually configured) when there's
+	// only one target to disambiguate.
+	_, targetResourceIdsConfigured := d.GetOk("target_resource_ids")
+	if len(compositeId.TargetResourceUris) > 1 || targetResourceIdsConfigured {
+		d.Set("target_resource_ids", compositeId.TargetResourceUris)
+	} else {
+		resourceUri := compositeId.TargetResourceUris[0]
+		if v, err := commonids.ParseKustoClusterIDInsensitively(resourceUri); err == nil {
+			resourceUri = v.ID()
+		}
+		d.Set("target_resource_id", resourceUri)
 	}
-	d.Set("target_resource_id", resourceUri)
 
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
@@ -549,13 +845,42 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 			}
 			d.Set("log_analytics_destination_type", logAnalyticsDestinationType)
 
+			representativeTargetResourceUri := compositeId.TargetResourceUris[0]
+
+			enablesAllLogs, enablesAllMetrics := false, false
+			if discoveredLogs, discoveredMetrics, err := discoverMonitorDiagnosticSettingCategories(ctx, meta, representativeTargetResourceUri); err == nil {
+				enablesAllLogs = monitorDiagnosticSettingMatchesDiscoveredCategories(enabledLogCategories(props.Logs), enabledLogCategories(&discoveredLogs))
+				enablesAllMetrics = monitorDiagnosticSettingMatchesDiscoveredCategories(enabledMetricCategories(props.Metrics), enabledMetricCategories(&discoveredMetrics))
+			} else {
+				log.Printf("[DEBUG] unable to discover Diagnostic Setting categories for Resource %q: %+v", representativeTargetResourceUri, err)
+			}
+
+			d.Set("enable_all_logs", enablesAllLogs)
+			d.Set("enable_all_metrics", enablesAllMetrics)
+
 			enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
-			if err = d.Set("enabled_log", enabledLogs); err != nil {
-				return fmt.Errorf("setting `enabled_log`: %+v", err)
+			if !enablesAllLogs {
+				destinationType := ""
+				if props.LogAnalyticsDestinationType != nil {
+					destinationType = *props.LogAnalyticsDestinationType
+				}
+				if workspaceId != "" && destinationType == "Dedicated" {
+					enabledLogs = flattenMonitorDiagnosticEnabledLogWorkspaceTables(ctx, meta, workspaceId, enabledLogs)
+				}
+
+				if err = d.Set("enabled_log", enabledLogs); err != nil {
+					return fmt.Errorf("setting `enabled_log`: %+v", err)
+				}
+			} else {
+				d.Set("enabled_log", []interface{}{})
 			}
 
-			if err = d.Set("enabled_metric", flattenMonitorDiagnosticEnabledMetrics(resp.Model.Properties.Metrics)); err != nil {
-				return fmt.Errorf("setting `enabled_metric`: %+v", err)
+			if !enablesAllMetrics {
+				if err = d.Set("enabled_metric", flattenMonitorDiagnosticEnabledMetrics(resp.Model.Properties.Metrics)); err != nil {
+					return fmt.Errorf("setting `enabled_metric`: %+v", err)
+				}
+			} else {
+				d.Set("enabled_metric", []interface{}{})
 			}
 
 			if !features.FivePointOh() {
@@ -570,19 +895,34 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 }
 
 func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseMonitorDiagnosticId(d.Id())
+	compositeId, err := ParseMonitorDiagnosticSettingID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, *id)
+	continueOnError := d.Get("continue_on_error").(bool)
+
+	_, err = monitorDiagnosticSettingApplyToTargets(compositeId.TargetResourceUris, continueOnError, func(targetResourceUri string) error {
+		return monitorDiagnosticSettingDeleteTarget(ctx, meta, compositeId.Name, targetResourceUri)
+	})
+	return err
+}
+
+func monitorDiagnosticSettingDeleteTarget(ctx context.Context, meta interface{}, name string, targetResourceUri string) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceUri, name)
+
+	publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseDeleting, nil)
+
+	resp, err := client.Delete(ctx, id)
 	if err != nil {
 		if !response.WasNotFound(resp.HttpResponse) {
-			return fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+			err = fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+			publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseError, err)
+			return err
 		}
 	}
 
@@ -596,15 +936,18 @@ func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta inte
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:                   []string{"Exists"},
 		Target:                    []string{"NotFound"},
-		Refresh:                   monitorDiagnosticSettingRefreshFunc(ctx, client, *id),
+		Refresh:                   monitorDiagnosticSettingRefreshFuncWithHeartbeat(ctx, id.ID(), targetResourceUri, name, monitorDiagnosticSettingRefreshFunc(ctx, client, id)),
 		MinTimeout:                15 * time.Second,
 		ContinuousTargetOccurence: 5,
 		Timeout:                   time.Until(deadline),
 	}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Monitor Diagnostic Setting %q for Resource %q to disappear: %s", id.DiagnosticSettingName, id.ResourceUri, err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		err = fmt.Errorf("waiting for Monitor Diagnostic Setting %q for Resource %q to disappear: %s", id.DiagnosticSettingName, id.ResourceUri, err)
+		publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseError, err)
+		return err
 	}
+	publishMonitorDiagnosticSettingEvent(ctx, id.ID(), targetResourceUri, name, events.PhaseDeleted, nil)
 
 	return nil
 }
@@ -623,6 +966,95 @@ func monitorDiagnosticSettingRefreshFunc(ctx context.Context, client *diagnostic
 	}
 }
 
+// discoverMonitorDiagnosticSettingCategories enumerates every Log and Metric category the target
+// resource's provider supports, so `enable_all_logs`/`enable_all_metrics` don't need a resource-type-
+// specific category list maintained (and kept in sync with Azure) in this provider.
+func discoverMonitorDiagnosticSettingCategories(ctx context.Context, meta interface{}, resourceUri string) (logs []diagnosticsettings.LogSettings, metrics []diagnosticsettings.MetricSettings, err error) {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
+
+	resp, err := client.List(ctx, diagnosticsettingscategory.NewScopeID(resourceUri))
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing Diagnostic Setting categories: %+v", err)
+	}
+
+	if resp.Model == nil {
+		return logs, metrics, nil
+	}
+
+	for _, category := range *resp.Model {
+		if category.Name == nil || category.Properties == nil || category.Properties.CategoryType == nil {
+			continue
+		}
+
+		switch *category.Properties.CategoryType {
+		case diagnosticsettingscategory.CategoryTypeLogs:
+			logs = append(logs, diagnosticsettings.LogSettings{
+				Enabled:  true,
+				Category: category.Name,
+			})
+		case diagnosticsettingscategory.CategoryTypeMetrics:
+			metrics = append(metrics, diagnosticsettings.MetricSettings{
+				Enabled:  true,
+				Category: category.Name,
+			})
+		}
+	}
+
+	return logs, metrics, nil
+}
+
+func enabledLogCategories(input *[]diagnosticsettings.LogSettings) []string {
+	categories := make([]string, 0)
+	if input == nil {
+		return categories
+	}
+
+	for _, v := range *input {
+		if v.Enabled && v.Category != nil {
+			categories = append(categories, *v.Category)
+		}
+	}
+
+	return categories
+}
+
+func enabledMetricCategories(input *[]diagnosticsettings.MetricSettings) []string {
+	categories := make([]string, 0)
+	if input == nil {
+		return categories
+	}
+
+	for _, v := range *input {
+		if v.Enabled && v.Category != nil {
+			categories = append(categories, *v.Category)
+		}
+	}
+
+	return categories
+}
+
+// monitorDiagnosticSettingMatchesDiscoveredCategories reports whether the setting's enabled categories
+// are exactly the discovered ones (same count, every discovered category enabled) - in which case `enable_all_logs`/`enable_all_metrics` should be persisted
+// to state instead of materializing each category individually.
+func monitorDiagnosticSettingMatchesDiscoveredCategories(enabledCategories []string, discoveredCategories []string) bool {
+	if len(discoveredCategories) == 0 || len(enabledCategories) != len(discoveredCategories) {
+		return false
+	}
+
+	enabled := make(map[string]bool, len(enabledCategories))
+	for _, category := range enabledCategories {
+		enabled[category] = true
+	}
+
+	for _, category := range discoveredCategories {
+		if !enabled[category] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func expandMonitorDiagnosticsSettingsEnabledLogs(input []interface{}) (*[]diagnosticsettings.LogSettings, error) {
 	results := make([]diagnosticsettings.LogSettings, 0)
 
@@ -701,12 +1133,95 @@ func flattenMonitorDiagnosticEnabledLogs(input *[]diagnosticsettings.LogSettings
 		}
 
 		output["retention_policy"] = policies
+		output["workspace_table"] = make([]interface{}, 0)
 
 		enabledLogs = append(enabledLogs, output)
 	}
 	return enabledLogs
 }
 
+// monitorDiagnosticSettingApplyWorkspaceTables pushes the `workspace_table` retention/plan declared
+// against each enabled log category through to the Dedicated table it lands in - the Diagnostic Setting
+// API itself has no concept of per-table retention, so this is a follow-up call against the workspace's
+// own tables client once the setting has been written.
+func monitorDiagnosticSettingApplyWorkspaceTables(ctx context.Context, meta interface{}, workspaceId string, enabledLogs []interface{}) error {
+	parsedWorkspaceId, err := workspaces.ParseWorkspaceIDInsensitively(workspaceId)
+	if err != nil {
+		return fmt.Errorf("parsing %q as a Log Analytics Workspace ID: %+v", workspaceId, err)
+	}
+
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+
+	for _, raw := range enabledLogs {
+		v := raw.(map[string]interface{})
+
+		category := v["category"].(string)
+		workspaceTables := v["workspace_table"].([]interface{})
+		if category == "" || len(workspaceTables) == 0 {
+			continue
+		}
+
+		workspaceTable := workspaceTables[0].(map[string]interface{})
+		properties := tables.TableProperties{}
+
+		if days := workspaceTable["retention_in_days"].(int); days != 0 {
+			properties.RetentionInDays = pointer.To(int64(days))
+		}
+		if days := workspaceTable["total_retention_in_days"].(int); days != 0 {
+			properties.TotalRetentionInDays = pointer.To(int64(days))
+		}
+		if plan := workspaceTable["plan"].(string); plan != "" {
+			properties.Plan = pointer.To(tables.TablePlanEnum(plan))
+		}
+
+		tableId := tables.NewTableID(parsedWorkspaceId.SubscriptionId, parsedWorkspaceId.ResourceGroupName, parsedWorkspaceId.WorkspaceName, category)
+		if _, err := client.Update(ctx, tableId, tables.Table{Properties: &properties}); err != nil {
+			return fmt.Errorf("updating retention for table %q in %s: %+v", category, parsedWorkspaceId, err)
+		}
+	}
+
+	return nil
+}
+
+// flattenMonitorDiagnosticEnabledLogWorkspaceTables reads back the Dedicated table settings for each
+// enabled log category, so a `workspace_table` block the user declared is reflected in state rather
+// than only existing as a one-way write.
+func flattenMonitorDiagnosticEnabledLogWorkspaceTables(ctx context.Context, meta interface{}, workspaceId string, enabledLogs []interface{}) []interface{} {
+	parsedWorkspaceId, err := workspaces.ParseWorkspaceIDInsensitively(workspaceId)
+	if err != nil {
+		log.Printf("[DEBUG] unable to parse %q as a Log Analytics Workspace ID: %+v", workspaceId, err)
+		return enabledLogs
+	}
+
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+
+	for _, raw := range enabledLogs {
+		v := raw.(map[string]interface{})
+
+		category, ok := v["category"].(string)
+		if !ok || category == "" {
+			continue
+		}
+
+		tableId := tables.NewTableID(parsedWorkspaceId.SubscriptionId, parsedWorkspaceId.ResourceGroupName, parsedWorkspaceId.WorkspaceName, category)
+		resp, err := client.Get(ctx, tableId)
+		if err != nil || resp.Model == nil || resp.Model.Properties == nil {
+			continue
+		}
+
+		props := resp.Model.Properties
+		v["workspace_table"] = []interface{}{
+			map[string]interface{}{
+				"retention_in_days":       int(pointer.From(props.RetentionInDays)),
+				"total_retention_in_days": int(pointer.From(props.TotalRetentionInDays)),
+				"plan":                    string(pointer.From(props.Plan)),
+			},
+		}
+	}
+
+	return enabledLogs
+}
+
 func flattenMonitorDiagnosticEnabledMetrics(input *[]diagnosticsettings.MetricSettings) []interface{} {
 	enabledLogs := make([]interface{}, 0)
 	if input == nil {
@@ -721,21 +1236,33 @@ func flattenMonitorDiagnosticEnabledMetrics(input *[]diagnosticsettings.MetricSe
 		}
 
 		output["category"] = pointer.From(v.Category)
+		output["category_group"] = pointer.From(v.CategoryGroup)
 
 		enabledLogs = append(enabledLogs, output)
 	}
 	return enabledLogs
 }
 
-func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []diagnosticsettings.MetricSettings {
+func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) ([]diagnosticsettings.MetricSettings, error) {
 	results := make([]diagnosticsettings.MetricSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
 
+		category := v["category"].(string)
+		categoryGroup := v["category_group"].(string)
+
 		output := diagnosticsettings.MetricSettings{
-			Category: pointer.To(v["category"].(string)),
-			Enabled:  v["enabled"].(bool),
+			Enabled: v["enabled"].(bool),
+		}
+
+		switch {
+		case category != "":
+			output.Category = pointer.To(category)
+		case categoryGroup != "":
+			output.CategoryGroup = pointer.To(categoryGroup)
+		default:
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified")
 		}
 
 		if !features.FivePointOh() {
@@ -756,24 +1283,35 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []diagnosticse
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
-func expandMonitorDiagnosticsSettingsEnabledMetrics(input []interface{}) []diagnosticsettings.MetricSettings {
+func expandMonitorDiagnosticsSettingsEnabledMetrics(input []interface{}) ([]diagnosticsettings.MetricSettings, error) {
 	results := make([]diagnosticsettings.MetricSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
 
+		category := v["category"].(string)
+		categoryGroup := v["category_group"].(string)
+
 		output := diagnosticsettings.MetricSettings{
-			Category: pointer.To(v["category"].(string)),
-			Enabled:  true,
+			Enabled: true,
+		}
+
+		switch {
+		case category != "":
+			output.Category = pointer.To(category)
+		case categoryGroup != "":
+			output.CategoryGroup = pointer.To(categoryGroup)
+		default:
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified")
 		}
 
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
 func flattenMonitorDiagnosticMetrics(input *[]diagnosticsettings.MetricSettings) []interface{} {
@@ -789,6 +1327,10 @@ func flattenMonitorDiagnosticMetrics(input *[]diagnosticsettings.MetricSettings)
 			output["category"] = *v.Category
 		}
 
+		if v.CategoryGroup != nil {
+			output["category_group"] = *v.CategoryGroup
+		}
+
 		output["enabled"] = v.Enabled
 
 		if !features.FivePointOh() {
@@ -812,15 +1354,147 @@ func flattenMonitorDiagnosticMetrics(input *[]diagnosticsettings.MetricSettings)
 	return results
 }
 
-func ParseMonitorDiagnosticId(monitorId string) (*diagnosticsettings.ScopedDiagnosticSettingId, error) {
-	v := strings.Split(monitorId, "|")
-	if len(v) != 2 {
-		return nil, fmt.Errorf("expected the Monitor Diagnostics ID to be in the format `{resourceId}|{name}` but got %d segments", len(v))
+// MonitorDiagnosticSettingId is the composite ID stored in state. With a single target it's encoded
+// identically to the legacy `{resourceId}|{name}` format this resource has always used, so existing
+// single-target state is unaffected; fanning out to `target_resource_ids` switches the
+// encoding to `{name}|{resourceId1},{resourceId2},...` since the name, not a single resource, is what's
+// constant across every target.
+type MonitorDiagnosticSettingId struct {
+	Name               string
+	TargetResourceUris []string
+}
+
+// NewMonitorDiagnosticSettingID builds the single-target form of MonitorDiagnosticSettingId.
+func NewMonitorDiagnosticSettingID(scope, name string) MonitorDiagnosticSettingId {
+	return MonitorDiagnosticSettingId{Name: name, TargetResourceUris: []string{scope}}
+}
+
+func (id MonitorDiagnosticSettingId) ID() string {
+	if len(id.TargetResourceUris) == 1 {
+		return fmt.Sprintf("%s|%s", id.TargetResourceUris[0], id.Name)
+	}
+
+	targets := append([]string{}, id.TargetResourceUris...)
+	sort.Strings(targets)
+	return fmt.Sprintf("%s|%s", id.Name, strings.Join(targets, ","))
+}
+
+// String is an alias of ID, so callers that otherwise hold a commonids-style resource ID don't need to
+// special-case this one.
+func (id MonitorDiagnosticSettingId) String() string {
+	return id.ID()
+}
+
+// ParseMonitorDiagnosticSettingID parses both the legacy single-target format and the fan-out format
+// described on MonitorDiagnosticSettingId. ARM resource IDs always begin with `/subscriptions/`, which
+// is enough to tell the two formats apart at runtime - the legacy single-target format round-trips
+// through this parser unchanged.
+func ParseMonitorDiagnosticSettingID(monitorId string) (*MonitorDiagnosticSettingId, error) {
+	v := strings.SplitN(monitorId, "|", 2)
+	if len(v) != 2 || v[0] == "" || v[1] == "" {
+		return nil, fmt.Errorf("expected the Monitor Diagnostics ID to be in the format `{resourceId}|{name}` or `{name}|{resourceId1},{resourceId2},...` but got %q", monitorId)
+	}
+
+	if strings.HasPrefix(v[0], "/subscriptions/") {
+		return &MonitorDiagnosticSettingId{Name: v[1], TargetResourceUris: []string{v[0]}}, nil
+	}
+
+	targets := strings.Split(v[1], ",")
+	for _, target := range targets {
+		if target == "" || !strings.HasPrefix(target, "/subscriptions/") {
+			return nil, fmt.Errorf("expected the Monitor Diagnostics ID %q to be in the format `{resourceId}|{name}` or `{name}|{resourceId1},{resourceId2},...` but target resource ID %q is empty or malformed", monitorId, target)
+		}
+	}
+
+	return &MonitorDiagnosticSettingId{Name: v[0], TargetResourceUris: targets}, nil
+}
+
+// ValidateMonitorDiagnosticSettingID is a pluginsdk.SchemaValidateFunc-compatible validator for
+// MonitorDiagnosticSettingId, following this provider's usual `validate.XID` convention.
+func ValidateMonitorDiagnosticSettingID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseMonitorDiagnosticSettingID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// monitorDiagnosticSettingTargetResourceIds resolves the configured target(s) - whichever of
+// `target_resource_id`/`target_resource_ids` is set - into a single sorted list, so Create/Update/Read/
+// Delete only need to deal with one shape.
+func monitorDiagnosticSettingTargetResourceIds(d *pluginsdk.ResourceData) []string {
+	if raw, ok := d.GetOk("target_resource_ids"); ok {
+		rawList := raw.([]interface{})
+		targets := make([]string, 0, len(rawList))
+		for _, v := range rawList {
+			targets = append(targets, v.(string))
+		}
+		sort.Strings(targets)
+		return targets
 	}
 
-	// TODO: this can become a Composite Resource ID once https://github.com/hashicorp/go-azure-helpers/pull/208 is released
-	identifier := diagnosticsettings.NewScopedDiagnosticSettingID(v[0], v[1])
-	return &identifier, nil
+	return []string{d.Get("target_resource_id").(string)}
+}
+
+// monitorDiagnosticSettingMaxConcurrentTargets bounds how many targets are processed at once when
+// fanning a setting out across `target_resource_ids`, so a large fleet doesn't overwhelm the ARM
+// throttling limits for a single subscription.
+const monitorDiagnosticSettingMaxConcurrentTargets = 8
+
+// monitorDiagnosticSettingApplyToTargets runs fn against every target with bounded concurrency. When
+// continueOnError is set a failing target is logged and skipped rather than failing the whole apply -
+// useful for large, heterogeneous fleets where a single missing or unsupported target shouldn't block
+// the rest.
+// monitorDiagnosticSettingApplyToTargets fans `fn` out across `targets` with bounded concurrency,
+// returning the subset of targets it actually succeeded against alongside any error - callers that
+// set the Terraform ID from the target list (namely Create) need that subset rather than the
+// originally-requested list, so a partial failure with `continue_on_error = false` still leaves every
+// target it *did* apply to tracked in state instead of orphaned in Azure.
+func monitorDiagnosticSettingApplyToTargets(targets []string, continueOnError bool, fn func(targetResourceUri string) error) ([]string, error) {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		errs      *multierror.Error
+		succeeded []string
+	)
+
+	sem := make(chan struct{}, monitorDiagnosticSettingMaxConcurrentTargets)
+
+	for _, target := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(target string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(target); err != nil {
+				if continueOnError {
+					log.Printf("[WARN] Monitor Diagnostic Setting: skipping Resource %q due to error (`continue_on_error` is enabled): %+v", target, err)
+					return
+				}
+
+				mu.Lock()
+				errs = multierror.Append(errs, fmt.Errorf("Resource %q: %+v", target, err))
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			succeeded = append(succeeded, target)
+			mu.Unlock()
+		}(target)
+	}
+
+	wg.Wait()
+
+	return succeeded, errs.ErrorOrNil()
 }
 
 func resourceMonitorDiagnosticLogSettingHash(input interface{}) int {
@@ -853,6 +1527,9 @@ func resourceMonitorDiagnosticMetricsSettingHash(input interface{}) int {
 		if category, ok := rawData["category"]; ok {
 			buf.WriteString(fmt.Sprintf("%s-", category.(string)))
 		}
+		if categoryGroup, ok := rawData["category_group"]; ok {
+			buf.WriteString(fmt.Sprintf("%s-", categoryGroup.(string)))
+		}
 		if enabled, ok := rawData["enabled"]; ok {
 			buf.WriteString(fmt.Sprintf("%t-", enabled.(bool)))
 		}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// privateDnsZoneSoaRecordId identifies the singleton SOA record of a Private DNS Zone. Azure doesn't
+// expose the SOA record as its own ARM resource, so this is modelled as the zone's ID plus the fixed
+// `SOA/@` record name rather than a generated `resourceids.Id`.
+type privateDnsZoneSoaRecordId struct {
+	PrivateDnsZoneId commonids.PrivateDnsZoneId
+}
+
+func newPrivateDnsZoneSoaRecordID(zoneId commonids.PrivateDnsZoneId) privateDnsZoneSoaRecordId {
+	return privateDnsZoneSoaRecordId{PrivateDnsZoneId: zoneId}
+}
+
+func (id privateDnsZoneSoaRecordId) ID() string {
+	return fmt.Sprintf("%s/SOA/%s", id.PrivateDnsZoneId.ID(), soaRecordName)
+}
+
+func (id privateDnsZoneSoaRecordId) String() string {
+	return fmt.Sprintf("SOA Record (%s)", id.PrivateDnsZoneId.String())
+}
+
+func parsePrivateDnsZoneSoaRecordID(input string) (*privateDnsZoneSoaRecordId, error) {
+	suffix := fmt.Sprintf("/SOA/%s", soaRecordName)
+	if len(input) <= len(suffix) || input[len(input)-len(suffix):] != suffix {
+		return nil, fmt.Errorf("expected an ID in the format `{privateDnsZoneId}%s` but got %q", suffix, input)
+	}
+
+	zoneId, err := commonids.ParsePrivateDnsZoneID(input[:len(input)-len(suffix)])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Private DNS Zone ID: %+v", input, err)
+	}
+
+	id := newPrivateDnsZoneSoaRecordID(*zoneId)
+	return &id, nil
+}
+
+func validatePrivateDnsZoneSoaRecordID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parsePrivateDnsZoneSoaRecordID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import "testing"
+
+func TestPrivateDnsZoneSoaRecordID(t *testing.T) {
+	testData := []struct {
+		Input string
+		Error bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1.com",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1.com/SOA/@",
+			Error: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		id, err := parsePrivateDnsZoneSoaRecordID(v.Input)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if actual := id.ID(); actual != v.Input {
+			t.Fatalf("expected %q but got %q", v.Input, actual)
+		}
+	}
+}
+
+func TestExpandPrivateDnsZoneSoaRecordEmail(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: "admin@contoso.com", Expected: "admin.contoso.com"},
+		{Input: "admin.contoso.com", Expected: "admin.contoso.com"},
+		{Input: "john.doe@contoso.com", Expected: `john\.doe.contoso.com`},
+	}
+
+	for _, v := range testData {
+		if actual := expandPrivateDnsZoneSoaRecordEmail(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenPrivateDnsZoneSoaRecordEmail(t *testing.T) {
+	if actual := flattenPrivateDnsZoneSoaRecordEmail("admin.contoso.com"); actual != "admin@contoso.com" {
+		t.Fatalf("expected %q but got %q", "admin@contoso.com", actual)
+	}
+}
+
+func TestFlattenPrivateDnsZoneSoaRecordEmail_dottedLocalPart(t *testing.T) {
+	if actual := flattenPrivateDnsZoneSoaRecordEmail(`john\.doe.contoso.com`); actual != "john.doe@contoso.com" {
+		t.Fatalf("expected %q but got %q", "john.doe@contoso.com", actual)
+	}
+}
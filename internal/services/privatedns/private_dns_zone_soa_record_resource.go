@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// soaRecordName is the fixed name Azure assigns the singleton SOA record of a Private DNS Zone.
+const soaRecordName = "@"
+
+type PrivateDnsZoneSoaRecordResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsZoneSoaRecordResource{}
+
+type PrivateDnsZoneSoaRecordModel struct {
+	PrivateDnsZoneId      string `tfschema:"private_dns_zone_id"`
+	Email                 string `tfschema:"email"`
+	ExpireTime            int64  `tfschema:"expire_time"`
+	Host                  string `tfschema:"host"`
+	MinimumTtl            int64  `tfschema:"minimum_ttl"`
+	RefreshTime           int64  `tfschema:"refresh_time"`
+	RetryTime             int64  `tfschema:"retry_time"`
+	TTL                   int64  `tfschema:"ttl"`
+	ProviderManagedSerial bool   `tfschema:"provider_managed_serial_enabled"`
+	SerialNumber          int64  `tfschema:"serial_number"`
+}
+
+func (r PrivateDnsZoneSoaRecordResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidatePrivateDnsZoneID,
+		},
+
+		"email": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"host": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsFQDN,
+		},
+
+		// RFC 1912 section 2.2 gives recommended floors for each timer below - these aren't hard
+		// API limits, but a value below them indicates a misconfiguration (e.g. secondaries refreshing
+		// or retrying so often they hammer the primary) that's worth failing Plan on rather than
+		// silently accepting.
+		"expire_time": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2419200,
+			ValidateFunc: validation.IntAtLeast(604800),
+		},
+
+		"minimum_ttl": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(60),
+		},
+
+		"refresh_time": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3600,
+			ValidateFunc: validation.IntAtLeast(1200),
+		},
+
+		"retry_time": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(180),
+		},
+
+		"ttl": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3600,
+			ValidateFunc: validation.IntAtLeast(60),
+		},
+
+		// When disabled the `serial_number` below becomes authoritative and is pushed on every
+		// Update; when enabled (the default) Create sends no serial and Update sends back the serial Azure
+		// currently holds, so Azure's auto-incremented value is kept.
+		"provider_managed_serial_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"serial_number": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func (r PrivateDnsZoneSoaRecordResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r PrivateDnsZoneSoaRecordResource) ModelObject() interface{} {
+	return &PrivateDnsZoneSoaRecordModel{}
+}
+
+func (r PrivateDnsZoneSoaRecordResource) ResourceType() string {
+	return "azurerm_private_dns_zone_soa_record"
+}
+
+func (r PrivateDnsZoneSoaRecordResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validatePrivateDnsZoneSoaRecordID
+}
+
+func (r PrivateDnsZoneSoaRecordResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsZoneSoaRecordModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			client := metadata.Client.PrivateDns.PrivateZonesClient
+
+			zoneId, err := commonids.ParsePrivateDnsZoneID(model.PrivateDnsZoneId)
+			if err != nil {
+				return err
+			}
+			id := newPrivateDnsZoneSoaRecordID(*zoneId)
+
+			existing, err := client.Get(ctx, *zoneId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("retrieving %s: %+v", zoneId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", zoneId)
+			}
+
+			// The SOA record is a property of the zone itself, created implicitly by Azure - there is
+			// nothing to "import" against, so treat any non-default values on it as drift rather than
+			// a conflicting resource.
+			payload := *existing.Model
+			payload.Properties.SoaRecord = expandPrivateDnsZoneSoaRecord(model)
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *zoneId, payload, privatezones.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsZoneSoaRecordResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parsePrivateDnsZoneSoaRecordID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsZoneSoaRecordModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			client := metadata.Client.PrivateDns.PrivateZonesClient
+
+			existing, err := client.Get(ctx, id.PrivateDnsZoneId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := *existing.Model
+			soa := expandPrivateDnsZoneSoaRecord(model)
+
+			// Never clobber a serial number Azure has auto-incremented on our behalf unless the user
+			// has explicitly opted out of provider-managed serials.
+			if model.ProviderManagedSerial && existing.Model.Properties.SoaRecord != nil {
+				soa.SerialNumber = existing.Model.Properties.SoaRecord.SerialNumber
+			}
+			payload.Properties.SoaRecord = soa
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id.PrivateDnsZoneId, payload, privatezones.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsZoneSoaRecordResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parsePrivateDnsZoneSoaRecordID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.PrivateDns.PrivateZonesClient
+			resp, err := client.Get(ctx, id.PrivateDnsZoneId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := PrivateDnsZoneSoaRecordModel{
+				PrivateDnsZoneId: id.PrivateDnsZoneId.ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if soa := model.Properties.SoaRecord; soa != nil {
+					state.Email = flattenPrivateDnsZoneSoaRecordEmail(pointer.From(soa.Email))
+					state.Host = pointer.From(soa.Host)
+					state.ExpireTime = pointer.From(soa.ExpireTime)
+					state.MinimumTtl = pointer.From(soa.MinimumTtl)
+					state.RefreshTime = pointer.From(soa.RefreshTime)
+					state.RetryTime = pointer.From(soa.RetryTime)
+					state.SerialNumber = pointer.From(soa.SerialNumber)
+				}
+				state.TTL = pointer.From(model.Properties.SoaRecord.Ttl)
+				metadata.ResourceData.Set("fqdn", fmt.Sprintf("%s.", id.PrivateDnsZoneId.PrivateDnsZoneName))
+			}
+
+			// `provider_managed_serial_enabled` has no server-side representation, so it's never
+			// overwritten on Read - it only governs what Update does with `serial_number`.
+			state.ProviderManagedSerial = metadata.ResourceData.Get("provider_managed_serial_enabled").(bool)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsZoneSoaRecordResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// The SOA record can't be deleted independently of its zone - Delete resets it to the
+			// Azure-assigned defaults so a subsequent `terraform apply` starts from a clean slate.
+			id, err := parsePrivateDnsZoneSoaRecordID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.PrivateDns.PrivateZonesClient
+			existing, err := client.Get(ctx, id.PrivateDnsZoneId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return nil
+			}
+
+			payload := *existing.Model
+			payload.Properties.SoaRecord = nil
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id.PrivateDnsZoneId, payload, privatezones.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("resetting %s to the Azure-managed defaults: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsZoneSoaRecord(model PrivateDnsZoneSoaRecordModel) *privatezones.SoaRecord {
+	soa := &privatezones.SoaRecord{
+		Email:       pointer.To(expandPrivateDnsZoneSoaRecordEmail(model.Email)),
+		Host:        pointer.To(model.Host),
+		ExpireTime:  pointer.To(model.ExpireTime),
+		MinimumTtl:  pointer.To(model.MinimumTtl),
+		RefreshTime: pointer.To(model.RefreshTime),
+		RetryTime:   pointer.To(model.RetryTime),
+		Ttl:         pointer.To(model.TTL),
+	}
+
+	if !model.ProviderManagedSerial && model.SerialNumber > 0 {
+		soa.SerialNumber = pointer.To(model.SerialNumber)
+	}
+
+	return soa
+}
+
+// expandPrivateDnsZoneSoaRecordEmail encodes `user@example.com` as `user.example.com`, the hostname
+// form Azure's SOA record expects, escaping any literal `.` in the local part as `\.` so
+// flattenPrivateDnsZoneSoaRecordEmail can find the `@` again unambiguously - local parts like
+// `firstname.lastname` routinely contain dots of their own.
+func expandPrivateDnsZoneSoaRecordEmail(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found {
+		// already in hostname form (e.g. round-tripped from state) - nothing to encode
+		return email
+	}
+
+	return strings.ReplaceAll(local, ".", `\.`) + "." + domain
+}
+
+// flattenPrivateDnsZoneSoaRecordEmail reverses expandPrivateDnsZoneSoaRecordEmail. It splits on the
+// first *unescaped* `.` rather than the first `.` outright, so a local part containing dots
+// round-trips correctly instead of being cut short.
+func flattenPrivateDnsZoneSoaRecordEmail(host string) string {
+	var local strings.Builder
+
+	for i := 0; i < len(host); i++ {
+		switch {
+		case host[i] == '\\' && i+1 < len(host) && host[i+1] == '.':
+			local.WriteByte('.')
+			i++
+		case host[i] == '.':
+			return local.String() + "@" + host[i+1:]
+		default:
+			local.WriteByte(host[i])
+		}
+	}
+
+	// no unescaped `.` found - not in the expected `local.domain` form
+	return host
+}
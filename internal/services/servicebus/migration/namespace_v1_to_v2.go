@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// NamespaceV1ToV2 cleans up the state left behind by the 2021-06-01-preview -> 2024-01-01 SDK jump:
+//   - `network_rule_set.network_rules.subnet_id` is rewritten to the casing the API returns, so the
+//     `suppress.CaseDifference` DiffSuppressFunc it used to need is no longer load-bearing.
+//   - the legacy `identity.0.principal_id`/`tenant_id` layout is rewritten into the current
+//     `SystemAssignedUserAssignedIdentityOptional` shape (which also expects `identity_ids`).
+//   - `customer_managed_key.0.key_vault_key_id` values stored without a version are resolved to the
+//     versioned form, since the provider has required a concrete key version for some time now.
+type NamespaceV1ToV2 struct{}
+
+func (NamespaceV1ToV2) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_rule_set": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"default_action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+					"public_network_access_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+					"trusted_services_allowed": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+					"ip_rules": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+					},
+					"network_rules": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"subnet_id": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"ignore_missing_vnet_service_endpoint": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type":         {Type: pluginsdk.TypeString, Optional: true},
+					"principal_id": {Type: pluginsdk.TypeString, Optional: true},
+					"tenant_id":    {Type: pluginsdk.TypeString, Optional: true},
+					"identity_ids": {Type: pluginsdk.TypeSet, Optional: true, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+				},
+			},
+		},
+
+		"customer_managed_key": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_vault_key_id":                  {Type: pluginsdk.TypeString, Required: true},
+					"identity_id":                       {Type: pluginsdk.TypeString, Required: true},
+					"infrastructure_encryption_enabled": {Type: pluginsdk.TypeBool, Optional: true},
+				},
+			},
+		},
+	}
+}
+
+func (NamespaceV1ToV2) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if ruleSets, ok := rawState["network_rule_set"].([]interface{}); ok {
+			for _, raw := range ruleSets {
+				ruleSet, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				rules, ok := ruleSet["network_rules"].([]interface{})
+				if !ok {
+					continue
+				}
+
+				normalized := make([]interface{}, 0)
+				for _, raw := range rules {
+					rule, ok := raw.(map[string]interface{})
+					if !ok {
+						continue
+					}
+
+					if subnetId, ok := rule["subnet_id"].(string); ok && subnetId != "" {
+						if id, err := commonids.ParseSubnetIDInsensitively(subnetId); err == nil {
+							rule["subnet_id"] = id.ID()
+						} else {
+							log.Printf("[DEBUG] could not normalize `subnet_id` %q during state migration: %+v", subnetId, err)
+						}
+					}
+
+					normalized = append(normalized, rule)
+				}
+
+				ruleSet["network_rules"] = normalized
+			}
+		}
+
+		if identities, ok := rawState["identity"].([]interface{}); ok {
+			for _, raw := range identities {
+				identity, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if _, ok := identity["identity_ids"]; !ok {
+					identity["identity_ids"] = []interface{}{}
+				}
+			}
+		}
+
+		if cmks, ok := rawState["customer_managed_key"].([]interface{}); ok {
+			for _, raw := range cmks {
+				cmk, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				keyId, ok := cmk["key_vault_key_id"].(string)
+				if !ok || keyId == "" {
+					continue
+				}
+
+				parsed, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyId)
+				if err != nil {
+					return nil, fmt.Errorf("parsing `customer_managed_key.0.key_vault_key_id` during state migration: %+v", err)
+				}
+				if parsed.Version != "" {
+					continue
+				}
+
+				resolved, err := resolveLatestKeyVaultKeyVersion(ctx, meta, parsed)
+				if err != nil {
+					log.Printf("[DEBUG] could not resolve a version for `customer_managed_key.0.key_vault_key_id` %q during state migration: %+v", keyId, err)
+					continue
+				}
+				cmk["key_vault_key_id"] = resolved
+			}
+		}
+
+		return rawState, nil
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+)
+
+// resolveLatestKeyVaultKeyVersion looks up the current version of a Key Vault Key so a versionless
+// `key_vault_key_id` recorded in state before the provider required a version can be migrated forward.
+func resolveLatestKeyVaultKeyVersion(ctx context.Context, meta interface{}, id *keyVaultParse.NestedItemId) (string, error) {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+
+	key, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("retrieving the current version of %q: %+v", id.Name, err)
+	}
+	if key.Key == nil || key.Key.Kid == nil {
+		return "", fmt.Errorf("retrieving the current version of %q: `key.kid` was nil", id.Name)
+	}
+
+	resolved, err := keyVaultParse.ParseNestedItemID(*key.Key.Kid)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q as a Key Vault Key ID: %+v", *key.Key.Kid, err)
+	}
+
+	return resolved.ID(), nil
+}
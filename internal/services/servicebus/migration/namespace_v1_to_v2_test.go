@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestNamespaceV1ToV2_NetworkRuleSetSubnetIdCasing(t *testing.T) {
+	input := map[string]interface{}{
+		"network_rule_set": []interface{}{
+			map[string]interface{}{
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"subnet_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1",
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := NamespaceV1ToV2{}.UpgradeFunc()(context.Background(), input, nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	ruleSet := actual["network_rule_set"].([]interface{})[0].(map[string]interface{})
+	rules := ruleSet["network_rules"].([]interface{})
+	rule := rules[0].(map[string]interface{})
+
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1"
+	if actual := rule["subnet_id"].(string); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNamespaceV1ToV2_IdentityIdsBackfilled(t *testing.T) {
+	input := map[string]interface{}{
+		"identity": []interface{}{
+			map[string]interface{}{
+				"type":         "SystemAssigned",
+				"principal_id": "11111111-1111-1111-1111-111111111111",
+				"tenant_id":    "22222222-2222-2222-2222-222222222222",
+			},
+		},
+	}
+
+	actual, err := NamespaceV1ToV2{}.UpgradeFunc()(context.Background(), input, nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	identity := actual["identity"].([]interface{})[0].(map[string]interface{})
+	if _, ok := identity["identity_ids"]; !ok {
+		t.Fatalf("expected `identity_ids` to be backfilled but it was missing")
+	}
+	if !reflect.DeepEqual(identity["identity_ids"], []interface{}{}) {
+		t.Fatalf("expected `identity_ids` to be an empty list but got %#v", identity["identity_ids"])
+	}
+}
+
+func TestNamespaceV1ToV2_CustomerManagedKeyAlreadyVersionedIsUnchanged(t *testing.T) {
+	keyId := "https://vault1.vault.azure.net/keys/key1/abcdef0123456789abcdef0123456789"
+	input := map[string]interface{}{
+		"customer_managed_key": []interface{}{
+			map[string]interface{}{
+				"key_vault_key_id": keyId,
+			},
+		},
+	}
+
+	actual, err := NamespaceV1ToV2{}.UpgradeFunc()(context.Background(), input, nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	cmk := actual["customer_managed_key"].([]interface{})[0].(map[string]interface{})
+	if actual := cmk["key_vault_key_id"].(string); actual != keyId {
+		t.Fatalf("expected %q to be left unchanged but got %q", keyId, actual)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// NamespaceV2ToV3 maps `network_rule_set.ip_rules` from a set of bare CIDR/IP strings into the set of
+// `{ ip_mask, action }` blocks the schema now expects, so existing configurations don't see a breaking
+// change when `action` support (Allow/Deny per rule) was added. Every migrated entry defaults to
+// `action = "Allow"`, matching the behaviour the provider always enforced previously.
+type NamespaceV2ToV3 struct{}
+
+func (NamespaceV2ToV3) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_rule_set": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_rules": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (NamespaceV2ToV3) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		ruleSets, ok := rawState["network_rule_set"].([]interface{})
+		if !ok {
+			return rawState, nil
+		}
+
+		for _, raw := range ruleSets {
+			ruleSet, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			ipRules, ok := ruleSet["ip_rules"].([]interface{})
+			if !ok {
+				continue
+			}
+
+			migrated := make([]interface{}, 0, len(ipRules))
+			for _, rawRule := range ipRules {
+				ipMask, ok := rawRule.(string)
+				if !ok {
+					// already in the new block shape - nothing to do
+					migrated = append(migrated, rawRule)
+					continue
+				}
+
+				migrated = append(migrated, map[string]interface{}{
+					"ip_mask": ipMask,
+					"action":  "Allow",
+				})
+			}
+
+			ruleSet["ip_rules"] = migrated
+		}
+
+		return rawState, nil
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNamespaceV2ToV3_IPRulesMigratedToBlocks(t *testing.T) {
+	input := map[string]interface{}{
+		"network_rule_set": []interface{}{
+			map[string]interface{}{
+				"ip_rules": []interface{}{"10.0.0.0/16", "192.168.1.1"},
+			},
+		},
+	}
+
+	actual, err := NamespaceV2ToV3{}.UpgradeFunc()(context.Background(), input, nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	ruleSet := actual["network_rule_set"].([]interface{})[0].(map[string]interface{})
+	ipRules := ruleSet["ip_rules"].([]interface{})
+
+	if len(ipRules) != 2 {
+		t.Fatalf("expected 2 ip_rules but got %d", len(ipRules))
+	}
+
+	first := ipRules[0].(map[string]interface{})
+	if actual := first["ip_mask"].(string); actual != "10.0.0.0/16" {
+		t.Fatalf("expected `ip_mask` to be %q but got %q", "10.0.0.0/16", actual)
+	}
+	if actual := first["action"].(string); actual != "Allow" {
+		t.Fatalf("expected `action` to default to %q but got %q", "Allow", actual)
+	}
+
+	second := ipRules[1].(map[string]interface{})
+	if actual := second["ip_mask"].(string); actual != "192.168.1.1" {
+		t.Fatalf("expected `ip_mask` to be %q but got %q", "192.168.1.1", actual)
+	}
+}
+
+func TestNamespaceV2ToV3_NoNetworkRuleSetIsUnchanged(t *testing.T) {
+	input := map[string]interface{}{
+		"name": "namespace1",
+	}
+
+	actual, err := NamespaceV2ToV3{}.UpgradeFunc()(context.Background(), input, nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if actual["name"].(string) != "namespace1" {
+		t.Fatalf("expected unrelated state to be left unchanged")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+var (
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+)
+
+// AssociatedGitHubLabel is the label used on GitHub to associate issues with this service
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/servicebus"
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "ServiceBus"
+}
+
+// WebsiteCategories returns the categories for this service
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Messaging",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_servicebus_namespace":                  resourceServiceBusNamespace(),
+		"azurerm_servicebus_namespace_network_rule_set": resourceServiceBusNamespaceNetworkRuleSet(),
+	}
+}
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		ServiceBusNamespacePrivateEndpointConnectionsDataSource{},
+	}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ServiceBusNamespaceCustomerManagedKeyResource{},
+		ServiceBusNamespacePrivateEndpointConnectionApprovalResource{},
+		ServiceBusNamespaceIPRuleResource{},
+		ServiceBusNamespaceVirtualNetworkRuleResource{},
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ServiceBusNamespaceCustomerManagedKeyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ServiceBusNamespaceCustomerManagedKeyResource{}
+
+type ServiceBusNamespaceCustomerManagedKeyModel struct {
+	NamespaceId                     string   `tfschema:"namespace_id"`
+	KeyVaultKeyIds                  []string `tfschema:"key_vault_key_id"`
+	UserAssignedIdentityId          string   `tfschema:"user_assigned_identity_id"`
+	InfrastructureEncryptionEnabled bool     `tfschema:"infrastructure_encryption_enabled"`
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		// A list (rather than the single value the inline `customer_managed_key` block on
+		// `azurerm_servicebus_namespace` accepts) so a key rotation can add the new key version
+		// before the old one is removed, and so multiple keys can be registered for re-encryption.
+		"key_vault_key_id": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+		},
+
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+
+		"infrastructure_encryption_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) ModelObject() interface{} {
+	return &ServiceBusNamespaceCustomerManagedKeyModel{}
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) ResourceType() string {
+	return "azurerm_servicebus_namespace_customer_managed_key"
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaces.ValidateNamespaceID
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ServiceBusNamespaceCustomerManagedKeyModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.properties` was nil", *id)
+			}
+
+			if encryption := existing.Model.Properties.Encryption; encryption != nil && encryption.KeyVaultProperties != nil && len(*encryption.KeyVaultProperties) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := existing.Model
+			encryption, err := expandServiceBusNamespaceCustomerManagedKey(model)
+			if err != nil {
+				return err
+			}
+			payload.Properties.Encryption = encryption
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("setting customer managed key for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ServiceBusNamespaceCustomerManagedKeyModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.properties` was nil", *id)
+			}
+
+			payload := existing.Model
+			if metadata.ResourceData.HasChangesExcept("namespace_id") {
+				encryption, err := expandServiceBusNamespaceCustomerManagedKey(model)
+				if err != nil {
+					return err
+				}
+				payload.Properties.Encryption = encryption
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating customer managed key for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ServiceBusNamespaceCustomerManagedKeyModel{
+				NamespaceId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if encryption := model.Properties.Encryption; encryption != nil {
+					state.InfrastructureEncryptionEnabled = pointer.From(encryption.RequireInfrastructureEncryption)
+
+					if keyVaultProperties := encryption.KeyVaultProperties; keyVaultProperties != nil {
+						keyIds := make([]string, 0)
+						for _, props := range *keyVaultProperties {
+							keyId, err := keyVaultParse.NewNestedItemID(pointer.From(props.KeyVaultUri), keyVaultParse.NestedItemTypeKey, pointer.From(props.KeyName), pointer.From(props.KeyVersion))
+							if err != nil {
+								return fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+							}
+							keyIds = append(keyIds, keyId.ID())
+
+							if state.UserAssignedIdentityId == "" && props.Identity != nil && props.Identity.UserAssignedIdentity != nil {
+								uaiId, err := commonids.ParseUserAssignedIdentityIDInsensitively(*props.Identity.UserAssignedIdentity)
+								if err != nil {
+									return err
+								}
+								state.UserAssignedIdentityId = uaiId.ID()
+							}
+						}
+						state.KeyVaultKeyIds = keyIds
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.properties` was nil", *id)
+			}
+
+			// Deleting this resource resets the namespace's encryption block back to
+			// platform-managed keys - it must not delete the namespace itself.
+			payload := existing.Model
+			payload.Properties.Encryption = nil
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("resetting customer managed key for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandServiceBusNamespaceCustomerManagedKey(model ServiceBusNamespaceCustomerManagedKeyModel) (*namespaces.Encryption, error) {
+	keyVaultProperties := make([]namespaces.KeyVaultProperties, 0)
+	for _, raw := range model.KeyVaultKeyIds {
+		keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+		}
+
+		keyVaultProperties = append(keyVaultProperties, namespaces.KeyVaultProperties{
+			KeyName:     pointer.To(keyId.Name),
+			KeyVersion:  pointer.To(keyId.Version),
+			KeyVaultUri: pointer.To(keyId.KeyVaultBaseUrl),
+			Identity: &namespaces.UserAssignedIdentityProperties{
+				UserAssignedIdentity: pointer.To(model.UserAssignedIdentityId),
+			},
+		})
+	}
+
+	keySource := namespaces.KeySourceMicrosoftPointKeyVault
+	return &namespaces.Encryption{
+		KeySource:                       &keySource,
+		RequireInfrastructureEncryption: pointer.To(model.InfrastructureEncryptionEnabled),
+		KeyVaultProperties:              &keyVaultProperties,
+	}, nil
+}
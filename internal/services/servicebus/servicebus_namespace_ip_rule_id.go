@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+)
+
+// serviceBusNamespaceIPRuleId identifies a single entry within a namespace's NetworkRuleSet. Azure
+// doesn't expose individual IP rules as their own ARM resource, so this is modelled as the namespace's
+// ID plus the rule's IP mask rather than a generated `resourceids.Id`.
+type serviceBusNamespaceIPRuleId struct {
+	NamespaceId namespaces.NamespaceId
+	IPMask      string
+}
+
+func (id serviceBusNamespaceIPRuleId) ID() string {
+	return fmt.Sprintf("%s|%s", id.NamespaceId.ID(), id.IPMask)
+}
+
+func (id serviceBusNamespaceIPRuleId) String() string {
+	return fmt.Sprintf("IP Rule %q (%s)", id.IPMask, id.NamespaceId.String())
+}
+
+func parseServiceBusNamespaceIPRuleID(input string) (*serviceBusNamespaceIPRuleId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return nil, fmt.Errorf("expected an ID in the format `{namespaceId}|{ipMask}` but got %q", input)
+	}
+
+	namespaceId, err := namespaces.ParseNamespaceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Service Bus Namespace ID: %+v", segments[0], err)
+	}
+
+	return &serviceBusNamespaceIPRuleId{
+		NamespaceId: *namespaceId,
+		IPMask:      segments[1],
+	}, nil
+}
+
+func validateServiceBusNamespaceIPRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parseServiceBusNamespaceIPRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
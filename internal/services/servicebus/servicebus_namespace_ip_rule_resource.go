@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ServiceBusNamespaceIPRuleResource manages a single entry in a namespace's NetworkRuleSet, so that
+// ownership of individual CIDRs can be split across Terraform states/modules rather than forcing one
+// state to own the whole `ip_rules` set on `azurerm_servicebus_namespace_network_rule_set`. It
+// should not be used on the same namespace as `azurerm_servicebus_namespace_network_rule_set`, which
+// always replaces the rule set wholesale and would fight this resource's merge-in-one-rule behaviour.
+type ServiceBusNamespaceIPRuleResource struct{}
+
+var _ sdk.Resource = ServiceBusNamespaceIPRuleResource{}
+
+type ServiceBusNamespaceIPRuleModel struct {
+	NamespaceId string `tfschema:"namespace_id"`
+	IPMask      string `tfschema:"ip_mask"`
+	Action      string `tfschema:"action"`
+	Name        string `tfschema:"name"`
+	Description string `tfschema:"description"`
+}
+
+func (r ServiceBusNamespaceIPRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"ip_mask": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPv4Address, validation.IsIPv6Address),
+		},
+
+		"action": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(namespaces.NetworkRuleIPActionAllow),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(namespaces.NetworkRuleIPActionAllow),
+				string(namespaces.NetworkRuleIPActionDeny),
+			}, false),
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ServiceBusNamespaceIPRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServiceBusNamespaceIPRuleResource) ModelObject() interface{} {
+	return &ServiceBusNamespaceIPRuleModel{}
+}
+
+func (r ServiceBusNamespaceIPRuleResource) ResourceType() string {
+	return "azurerm_servicebus_namespace_ip_rule"
+}
+
+func (r ServiceBusNamespaceIPRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validateServiceBusNamespaceIPRuleID
+}
+
+func (r ServiceBusNamespaceIPRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ServiceBusNamespaceIPRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			namespaceId, err := namespaces.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(namespaceId.NamespaceName, "azurerm_servicebus_namespace")
+			defer locks.UnlockByName(namespaceId.NamespaceName, "azurerm_servicebus_namespace")
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+
+			existing, err := client.GetNetworkRuleSet(ctx, *namespaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving network rule set for %s: %+v", namespaceId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving network rule set for %s: `model.properties` was nil", namespaceId)
+			}
+
+			props := existing.Model.Properties
+			ipRules := make([]namespaces.NWRuleSetIPRules, 0)
+			if props.IPRules != nil {
+				for _, rule := range *props.IPRules {
+					if rule.IPMask != nil && *rule.IPMask == model.IPMask {
+						return metadata.ResourceRequiresImport(r.ResourceType(), serviceBusNamespaceIPRuleId{NamespaceId: *namespaceId, IPMask: model.IPMask})
+					}
+					ipRules = append(ipRules, rule)
+				}
+			}
+
+			newRule := expandServiceBusNamespaceIPRules([]interface{}{
+				map[string]interface{}{
+					"ip_mask": model.IPMask,
+					"action":  model.Action,
+				},
+			})
+			ipRules = append(ipRules, (*newRule)[0])
+			props.IPRules = &ipRules
+
+			if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, *namespaceId, namespaces.NetworkRuleSet{Properties: props}); err != nil {
+				return fmt.Errorf("creating IP Rule %q for %s: %+v", model.IPMask, namespaceId, err)
+			}
+
+			id := serviceBusNamespaceIPRuleId{NamespaceId: *namespaceId, IPMask: model.IPMask}
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServiceBusNamespaceIPRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parseServiceBusNamespaceIPRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+			resp, err := client.GetNetworkRuleSet(ctx, id.NamespaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving network rule set for %s: %+v", id, err)
+			}
+
+			state := ServiceBusNamespaceIPRuleModel{
+				NamespaceId: id.NamespaceId.ID(),
+				IPMask:      id.IPMask,
+			}
+
+			found := false
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.IPRules != nil {
+				for _, rule := range *model.Properties.IPRules {
+					if rule.IPMask != nil && *rule.IPMask == id.IPMask {
+						found = true
+						state.Action = string(namespaces.NetworkRuleIPActionAllow)
+						if rule.Action != nil {
+							state.Action = string(*rule.Action)
+						}
+						break
+					}
+				}
+			}
+			if !found {
+				return metadata.MarkAsGone(id)
+			}
+
+			// `name`/`description` have no server-side representation on an IP rule - they're never
+			// overwritten on Read, the same as `azurerm_servicebus_namespace_network_rule_set`.
+			state.Name = metadata.ResourceData.Get("name").(string)
+			state.Description = metadata.ResourceData.Get("description").(string)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServiceBusNamespaceIPRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parseServiceBusNamespaceIPRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.NamespaceId.NamespaceName, "azurerm_servicebus_namespace")
+			defer locks.UnlockByName(id.NamespaceId.NamespaceName, "azurerm_servicebus_namespace")
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+
+			existing, err := client.GetNetworkRuleSet(ctx, id.NamespaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving network rule set for %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return nil
+			}
+
+			props := existing.Model.Properties
+			if props.IPRules == nil {
+				return nil
+			}
+
+			ipRules := make([]namespaces.NWRuleSetIPRules, 0)
+			for _, rule := range *props.IPRules {
+				if rule.IPMask != nil && *rule.IPMask == id.IPMask {
+					continue
+				}
+				ipRules = append(ipRules, rule)
+			}
+			props.IPRules = &ipRules
+
+			if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, id.NamespaceId, namespaces.NetworkRuleSet{Properties: props}); err != nil {
+				return fmt.Errorf("removing IP Rule %q for %s: %+v", id.IPMask, id.NamespaceId, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// resourceServiceBusNamespaceNetworkRuleSet manages the namespace's entire NetworkRuleSet
+// declaratively - its Create/Update always replaces `ip_rules`/`network_rules` wholesale, so it
+// should not be used on the same namespace as `azurerm_servicebus_namespace_ip_rule` or
+// `azurerm_servicebus_namespace_virtual_network_rule`, whose Create/Update merge a single rule into
+// whatever's already there. Combining them is a conflict, not a supported layering, in the same way
+// `azurerm_network_security_group`'s inline `security_rule` block can't be mixed with standalone
+// `azurerm_network_security_rule` resources on that NSG.
+func resourceServiceBusNamespaceNetworkRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceServiceBusNamespaceNetworkRuleSetCreateUpdate,
+		Read:   resourceServiceBusNamespaceNetworkRuleSetRead,
+		Update: resourceServiceBusNamespaceNetworkRuleSetCreateUpdate,
+		Delete: resourceServiceBusNamespaceNetworkRuleSetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := namespaces.ParseNamespaceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"namespace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: namespaces.ValidateNamespaceID,
+			},
+
+			"default_action": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(namespaces.DefaultActionAllow),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(namespaces.DefaultActionAllow),
+					string(namespaces.DefaultActionDeny),
+				}, false),
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"trusted_services_allowed": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ip_rules": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Set:      ipRuleHash,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"ip_mask": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPv4Address, validation.IsIPv6Address),
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(namespaces.NetworkRuleIPActionAllow),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(namespaces.NetworkRuleIPActionAllow),
+								string(namespaces.NetworkRuleIPActionDeny),
+							}, false),
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"network_rules": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Set:      networkRuleHash,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: commonids.ValidateSubnetID,
+							// The subnet ID returned from the service will have `resourceGroup/{resourceGroupName}` all in lower cases...
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+						"ignore_missing_vnet_service_endpoint": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceBusNamespaceNetworkRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NamespaceName, "azurerm_servicebus_namespace")
+	defer locks.UnlockByName(id.NamespaceName, "azurerm_servicebus_namespace")
+
+	if d.IsNewResource() {
+		existing, err := client.GetNetworkRuleSet(ctx, *id)
+		if err != nil && !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing network rule set for %s: %+v", id, err)
+		}
+		if props := existing.Model; props != nil && props.Properties != nil {
+			if props.Properties.DefaultAction != nil && *props.Properties.DefaultAction == namespaces.DefaultActionDeny {
+				return tf.ImportAsExistsError("azurerm_servicebus_namespace_network_rule_set", id.ID())
+			}
+		}
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"default_action":                d.Get("default_action").(string),
+			"public_network_access_enabled": d.Get("public_network_access_enabled").(bool),
+			"trusted_services_allowed":      d.Get("trusted_services_allowed").(bool),
+			"ip_rules":                      d.Get("ip_rules").(*pluginsdk.Set),
+			"network_rules":                 d.Get("network_rules").(*pluginsdk.Set),
+		},
+	}
+
+	if err := createNetworkRuleSetForNamespace(ctx, client, *id, input); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceServiceBusNamespaceNetworkRuleSetRead(d, meta)
+}
+
+func resourceServiceBusNamespaceNetworkRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetNetworkRuleSet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving network rule set for %s: %+v", id, err)
+	}
+
+	d.Set("namespace_id", id.ID())
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		existing := []interface{}{
+			map[string]interface{}{
+				"ip_rules":      d.Get("ip_rules"),
+				"network_rules": d.Get("network_rules"),
+			},
+		}
+		ruleSet := flattenServiceBusNamespaceNetworkRuleSet(*model.Properties, existing)[0].(map[string]interface{})
+		d.Set("default_action", ruleSet["default_action"])
+		d.Set("public_network_access_enabled", ruleSet["public_network_access_enabled"])
+		d.Set("trusted_services_allowed", ruleSet["trusted_services_allowed"])
+		d.Set("ip_rules", ruleSet["ip_rules"])
+		d.Set("network_rules", ruleSet["network_rules"])
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceNetworkRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NamespaceName, "azurerm_servicebus_namespace")
+	defer locks.UnlockByName(id.NamespaceName, "azurerm_servicebus_namespace")
+
+	if err := resetNetworkRuleSetForNamespace(ctx, client, *id); err != nil {
+		return err
+	}
+
+	return nil
+}
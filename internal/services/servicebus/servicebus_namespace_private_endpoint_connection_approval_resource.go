@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ServiceBusNamespacePrivateEndpointConnectionApprovalResource struct{}
+
+var _ sdk.ResourceWithUpdate = ServiceBusNamespacePrivateEndpointConnectionApprovalResource{}
+
+type ServiceBusNamespacePrivateEndpointConnectionApprovalModel struct {
+	PrivateEndpointConnectionId string `tfschema:"private_endpoint_connection_id"`
+	Status                      string `tfschema:"status"`
+	Description                 string `tfschema:"description"`
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_connection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privateendpointconnections.ValidatePrivateEndpointConnectionID,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(privateendpointconnections.PrivateLinkConnectionStatusApproved),
+				string(privateendpointconnections.PrivateLinkConnectionStatusRejected),
+			}, false),
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) ModelObject() interface{} {
+	return &ServiceBusNamespacePrivateEndpointConnectionApprovalModel{}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) ResourceType() string {
+	return "azurerm_servicebus_namespace_private_endpoint_connection_approval"
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privateendpointconnections.ValidatePrivateEndpointConnectionID
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ServiceBusNamespacePrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.PrivateEndpointConnectionsClient
+
+			id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(model.PrivateEndpointConnectionId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			payload.Properties.PrivateLinkServiceConnectionState = &privateendpointconnections.ConnectionState{
+				Status:      pointer.To(privateendpointconnections.PrivateLinkConnectionStatus(model.Status)),
+				Description: pointer.To(model.Description),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("setting the status of %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ServiceBusNamespacePrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.PrivateEndpointConnectionsClient
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			payload.Properties.PrivateLinkServiceConnectionState = &privateendpointconnections.ConnectionState{
+				Status:      pointer.To(privateendpointconnections.PrivateLinkConnectionStatus(model.Status)),
+				Description: pointer.To(model.Description),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating the status of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.PrivateEndpointConnectionsClient
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ServiceBusNamespacePrivateEndpointConnectionApprovalModel{
+				PrivateEndpointConnectionId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if connectionState := model.Properties.PrivateLinkServiceConnectionState; connectionState != nil {
+					state.Status = string(pointer.From(connectionState.Status))
+					state.Description = pointer.From(connectionState.Description)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionApprovalResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Deleting this resource only relinquishes Terraform's management of the approval
+			// decision - it must not delete the private endpoint connection itself, since that's
+			// owned by the `azurerm_private_endpoint` on the consumer side.
+			return nil
+		},
+	}
+}
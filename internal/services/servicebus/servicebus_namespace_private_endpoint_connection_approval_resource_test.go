@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceBusNamespacePrivateEndpointConnectionApprovalResource struct{}
+
+func TestAccServiceBusNamespacePrivateEndpointConnectionApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_private_endpoint_connection_approval", "test")
+	r := ServiceBusNamespacePrivateEndpointConnectionApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+			),
+		},
+	})
+}
+
+func (ServiceBusNamespacePrivateEndpointConnectionApprovalResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceBus.PrivateEndpointConnectionsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ServiceBusNamespacePrivateEndpointConnectionApprovalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebus%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctestpsc-%[1]d"
+    private_connection_resource_id = azurerm_servicebus_namespace.test.id
+    subresource_names              = ["namespace"]
+    is_manual_connection           = true
+  }
+}
+
+resource "azurerm_servicebus_namespace_private_endpoint_connection_approval" "test" {
+  private_endpoint_connection_id = azurerm_private_endpoint.test.private_service_connection.0.id
+  status                         = "Approved"
+  description                    = "Approved by Terraform"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
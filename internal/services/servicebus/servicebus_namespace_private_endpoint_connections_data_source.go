@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ServiceBusNamespacePrivateEndpointConnectionsDataSource struct{}
+
+var _ sdk.DataSource = ServiceBusNamespacePrivateEndpointConnectionsDataSource{}
+
+type ServiceBusNamespacePrivateEndpointConnectionsDataSourceModel struct {
+	NamespaceId string                                                       `tfschema:"namespace_id"`
+	Connections []ServiceBusNamespacePrivateEndpointConnectionDataSourceItem `tfschema:"connections"`
+}
+
+type ServiceBusNamespacePrivateEndpointConnectionDataSourceItem struct {
+	Id                string `tfschema:"id"`
+	PrivateEndpointId string `tfschema:"private_endpoint_id"`
+	Status            string `tfschema:"status"`
+	Description       string `tfschema:"description"`
+	ActionsRequired   string `tfschema:"actions_required"`
+	ProvisioningState string `tfschema:"provisioning_state"`
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+	}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"connections": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"private_endpoint_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"actions_required": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"provisioning_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionsDataSource) ModelObject() interface{} {
+	return &ServiceBusNamespacePrivateEndpointConnectionsDataSourceModel{}
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionsDataSource) ResourceType() string {
+	return "azurerm_servicebus_namespace_private_endpoint_connections"
+}
+
+func (r ServiceBusNamespacePrivateEndpointConnectionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ServiceBusNamespacePrivateEndpointConnectionsDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			namespaceId, err := namespaces.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.PrivateEndpointConnectionsClient
+			parentId := privateendpointconnections.NewNamespaceID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName)
+
+			resp, err := client.ListComplete(ctx, parentId)
+			if err != nil {
+				return fmt.Errorf("listing Private Endpoint Connections for %s: %+v", namespaceId, err)
+			}
+
+			connections := make([]ServiceBusNamespacePrivateEndpointConnectionDataSourceItem, 0)
+			for _, item := range resp.Items {
+				connection := ServiceBusNamespacePrivateEndpointConnectionDataSourceItem{
+					Id: pointer.From(item.Id),
+				}
+
+				if props := item.Properties; props != nil {
+					if props.PrivateEndpoint != nil {
+						connection.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+					}
+					if state := props.PrivateLinkServiceConnectionState; state != nil {
+						connection.Status = string(pointer.From(state.Status))
+						connection.Description = pointer.From(state.Description)
+						connection.ActionsRequired = pointer.From(state.ActionsRequired)
+					}
+					connection.ProvisioningState = string(pointer.From(props.ProvisioningState))
+				}
+
+				connections = append(connections, connection)
+			}
+
+			model.Connections = connections
+
+			metadata.SetID(namespaceId)
+			return metadata.Encode(&model)
+		},
+	}
+}
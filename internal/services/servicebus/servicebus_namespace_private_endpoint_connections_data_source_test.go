@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+)
+
+type ServiceBusNamespacePrivateEndpointConnectionsDataSource struct{}
+
+func TestAccServiceBusNamespacePrivateEndpointConnectionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_namespace_private_endpoint_connections", "test")
+	r := ServiceBusNamespacePrivateEndpointConnectionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				acceptance.TestCheckResourceAttrSet(data.ResourceName, "connections.#"),
+			),
+		},
+	})
+}
+
+func (ServiceBusNamespacePrivateEndpointConnectionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebus%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+data "azurerm_servicebus_namespace_private_endpoint_connections" "test" {
+  namespace_id = azurerm_servicebus_namespace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
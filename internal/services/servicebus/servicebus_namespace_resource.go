@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/disasterrecoveryconfigs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespacesauthorizationrule"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -54,9 +55,11 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 			return err
 		}),
 
-		SchemaVersion: 1,
+		SchemaVersion: 3,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.NamespaceV0ToV1{},
+			1: migration.NamespaceV1ToV2{},
+			2: migration.NamespaceV2ToV3{},
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -106,9 +109,10 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 			},
 
 			"customer_managed_key": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:       pluginsdk.TypeList,
+				Optional:   true,
+				MaxItems:   1,
+				Deprecated: "`customer_managed_key` will be deprecated in favour of the `azurerm_servicebus_namespace_customer_managed_key` resource in v5.0 of the AzureRM provider - to manage CMK rotation and imports independently of the namespace, use that resource instead",
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"key_vault_key_id": {
@@ -177,11 +181,38 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			// Populated when the namespace is paired via `azurerm_servicebus_namespace_disaster_recovery_config`
+			// - these are scoped to the alias rather than the namespace, so they keep working through a failover.
+			"alias_primary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"alias_secondary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"alias_default_primary_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"alias_default_secondary_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"network_rule_set": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:       pluginsdk.TypeList,
+				Optional:   true,
+				Computed:   true,
+				MaxItems:   1,
+				Deprecated: "`network_rule_set` will be deprecated in favour of the `azurerm_servicebus_namespace_network_rule_set` resource in v5.0 of the AzureRM provider - to manage the network rule set independently of the namespace, use that resource instead",
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"default_action": {
@@ -203,8 +234,41 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 						"ip_rules": {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+							Set:      ipRuleHash,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"ip_mask": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPv4Address, validation.IsIPv6Address),
+									},
+
+									"action": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										Default:  string(namespaces.NetworkRuleIPActionAllow),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(namespaces.NetworkRuleIPActionAllow),
+											string(namespaces.NetworkRuleIPActionDeny),
+										}, false),
+									},
+
+									// `name` and `description` aren't persisted by the Service Bus API - the
+									// provider stores them in state only, keyed off `ip_mask`, so large rule
+									// sets can be given human-readable identities and diffs/drift errors cite
+									// the rule name rather than an opaque CIDR.
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"description": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
 							},
 						},
 
@@ -232,6 +296,22 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 										Optional: true,
 										Default:  false,
 									},
+
+									// `name` and `description` aren't persisted by the Service Bus API - the
+									// provider stores them in state only, keyed off `subnet_id`, so large rule
+									// sets can be given human-readable identities and diffs/drift errors cite
+									// the rule name rather than an opaque subnet ID.
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"description": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
 								},
 							},
 						},
@@ -567,6 +647,10 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if err := setServiceBusNamespaceAliasKeys(ctx, meta, d, *id); err != nil {
+		log.Printf("[WARN] %+v", err)
+	}
+
 	networkRuleSet, err := client.GetNetworkRuleSet(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving network rule set %s: %+v", *id, err)
@@ -574,7 +658,8 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 
 	if model := networkRuleSet.Model; model != nil {
 		if props := model.Properties; props != nil {
-			d.Set("network_rule_set", flattenServiceBusNamespaceNetworkRuleSet(*props))
+			existingNetworkRuleSet := d.Get("network_rule_set").([]interface{})
+			d.Set("network_rule_set", flattenServiceBusNamespaceNetworkRuleSet(*props, existingNetworkRuleSet))
 		}
 	}
 
@@ -603,6 +688,38 @@ func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+// setServiceBusNamespaceAliasKeys populates the `alias_*` computed attributes when the namespace is
+// paired via a `azurerm_servicebus_namespace_disaster_recovery_config` - these are resolved through the
+// alias' own default authorization rule so they keep resolving to the live namespace through a failover.
+func setServiceBusNamespaceAliasKeys(ctx context.Context, meta interface{}, d *pluginsdk.ResourceData, id namespaces.NamespaceId) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+
+	configs, err := client.ListComplete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing disaster recovery configs for %s: %+v", id, err)
+	}
+	if len(configs.Items) == 0 {
+		return nil
+	}
+
+	aliasName := pointer.From(configs.Items[0].Name)
+	authRuleId := disasterrecoveryconfigs.NewAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, aliasName, serviceBusNamespaceDefaultAuthorizationRule)
+
+	keys, err := client.ListKeys(ctx, authRuleId)
+	if err != nil {
+		return fmt.Errorf("listing keys for the disaster recovery config alias of %s: %+v", id, err)
+	}
+
+	if keysModel := keys.Model; keysModel != nil {
+		d.Set("alias_primary_connection_string", keysModel.PrimaryConnectionString)
+		d.Set("alias_secondary_connection_string", keysModel.SecondaryConnectionString)
+		d.Set("alias_default_primary_key", keysModel.PrimaryKey)
+		d.Set("alias_default_secondary_key", keysModel.SecondaryKey)
+	}
+
+	return nil
+}
+
 func expandServiceBusNamespaceEncryption(input []interface{}) *namespaces.Encryption {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -768,7 +885,7 @@ func resetNetworkRuleSetForNamespace(ctx context.Context, client *namespaces.Nam
 	return nil
 }
 
-func flattenServiceBusNamespaceNetworkRuleSet(networkRuleSet namespaces.NetworkRuleSetProperties) []interface{} {
+func flattenServiceBusNamespaceNetworkRuleSet(networkRuleSet namespaces.NetworkRuleSetProperties, existing []interface{}) []interface{} {
 	defaultAction := ""
 	if v := networkRuleSet.DefaultAction; v != nil {
 		defaultAction = string(*v)
@@ -783,26 +900,56 @@ func flattenServiceBusNamespaceNetworkRuleSet(networkRuleSet namespaces.NetworkR
 		trustedServiceEnabled = *networkRuleSet.TrustedServiceAccessEnabled
 	}
 
-	networkRules := flattenServiceBusNamespaceVirtualNetworkRules(networkRuleSet.VirtualNetworkRules)
-	ipRules := flattenServiceBusNamespaceIPRules(networkRuleSet.IPRules)
+	var existingIPRules, existingNetworkRules []interface{}
+	if len(existing) > 0 && existing[0] != nil {
+		existingItem := existing[0].(map[string]interface{})
+		if v, ok := existingItem["ip_rules"].(*pluginsdk.Set); ok {
+			existingIPRules = v.List()
+		}
+		if v, ok := existingItem["network_rules"].(*pluginsdk.Set); ok {
+			existingNetworkRules = v.List()
+		}
+	}
+
+	networkRules := flattenServiceBusNamespaceVirtualNetworkRules(networkRuleSet.VirtualNetworkRules, existingNetworkRules)
+	ipRules := flattenServiceBusNamespaceIPRules(networkRuleSet.IPRules, existingIPRules)
 
 	return []interface{}{map[string]interface{}{
 		"default_action":                defaultAction,
 		"trusted_services_allowed":      trustedServiceEnabled,
 		"public_network_access_enabled": publicNetworkAccess == namespaces.PublicNetworkAccessFlagEnabled,
 		"network_rules":                 pluginsdk.NewSet(networkRuleHash, networkRules),
-		"ip_rules":                      ipRules,
+		"ip_rules":                      pluginsdk.NewSet(ipRuleHash, ipRules),
 	}}
 }
 
+// networkRuleHash hashes on `name` when the rule has been given one, so that renumbering/reordering a
+// large rule set doesn't churn every other rule's diff; unnamed rules fall back to the previous
+// subnet-only hash for backwards compatibility. The service returns the subnet ID with the
+// `resourceGroup` segment and resource group name all in lower case, so it's hashed
+// case-insensitively here to avoid unnecessary diffs.
 func networkRuleHash(input interface{}) int {
 	v := input.(map[string]interface{})
 
-	// we are just taking subnet_id into the hash function and ignore the ignore_missing_vnet_service_endpoint to ensure there would be no duplicates of subnet id
-	// the service returns this ID with segment resourceGroup and resource group name all in lower cases, to avoid unnecessary diff, we extract this ID and reconstruct this hash code
+	if name, ok := v["name"].(string); ok && name != "" {
+		return set.HashStringIgnoreCase(name)
+	}
+
 	return set.HashStringIgnoreCase(v["subnet_id"])
 }
 
+// ipRuleHash hashes on `name` when the rule has been given one, for the same reason as networkRuleHash;
+// unnamed rules fall back to hashing `ip_mask`.
+func ipRuleHash(input interface{}) int {
+	v := input.(map[string]interface{})
+
+	if name, ok := v["name"].(string); ok && name != "" {
+		return set.HashStringIgnoreCase(name)
+	}
+
+	return set.HashStringIgnoreCase(v["ip_mask"])
+}
+
 func expandServiceBusNamespaceVirtualNetworkRules(input []interface{}) *[]namespaces.NWRuleSetVirtualNetworkRules {
 	if len(input) == 0 {
 		return nil
@@ -822,11 +969,17 @@ func expandServiceBusNamespaceVirtualNetworkRules(input []interface{}) *[]namesp
 	return &result
 }
 
-func flattenServiceBusNamespaceVirtualNetworkRules(input *[]namespaces.NWRuleSetVirtualNetworkRules) []interface{} {
+func flattenServiceBusNamespaceVirtualNetworkRules(input *[]namespaces.NWRuleSetVirtualNetworkRules, existing []interface{}) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
+	existingBySubnetId := make(map[string]map[string]interface{}, len(existing))
+	for _, v := range existing {
+		raw := v.(map[string]interface{})
+		existingBySubnetId[raw["subnet_id"].(string)] = raw
+	}
+
 	result := make([]interface{}, 0, len(*input))
 	for _, v := range *input {
 		subnetId := ""
@@ -839,9 +992,17 @@ func flattenServiceBusNamespaceVirtualNetworkRules(input *[]namespaces.NWRuleSet
 			ignore = *v.IgnoreMissingVnetServiceEndpoint
 		}
 
+		name, description := "", ""
+		if raw, ok := existingBySubnetId[subnetId]; ok {
+			name = raw["name"].(string)
+			description = raw["description"].(string)
+		}
+
 		result = append(result, map[string]interface{}{
 			"subnet_id":                            subnetId,
 			"ignore_missing_vnet_service_endpoint": ignore,
+			"name":                                 name,
+			"description":                          description,
 		})
 	}
 
@@ -853,11 +1014,12 @@ func expandServiceBusNamespaceIPRules(input []interface{}) *[]namespaces.NWRuleS
 		return nil
 	}
 
-	action := namespaces.NetworkRuleIPActionAllow
 	result := make([]namespaces.NWRuleSetIPRules, 0, len(input))
 	for _, v := range input {
+		raw := v.(map[string]interface{})
+		action := namespaces.NetworkRuleIPAction(raw["action"].(string))
 		result = append(result, namespaces.NWRuleSetIPRules{
-			IPMask: pointer.To(v.(string)),
+			IPMask: pointer.To(raw["ip_mask"].(string)),
 			Action: &action,
 		})
 	}
@@ -865,16 +1027,38 @@ func expandServiceBusNamespaceIPRules(input []interface{}) *[]namespaces.NWRuleS
 	return &result
 }
 
-func flattenServiceBusNamespaceIPRules(input *[]namespaces.NWRuleSetIPRules) []interface{} {
+func flattenServiceBusNamespaceIPRules(input *[]namespaces.NWRuleSetIPRules, existing []interface{}) []interface{} {
 	if input == nil || len(*input) == 0 {
 		return []interface{}{}
 	}
 
+	existingByIPMask := make(map[string]map[string]interface{}, len(existing))
+	for _, v := range existing {
+		raw := v.(map[string]interface{})
+		existingByIPMask[raw["ip_mask"].(string)] = raw
+	}
+
 	result := make([]interface{}, 0, len(*input))
 	for _, v := range *input {
-		if v.IPMask != nil {
-			result = append(result, *v.IPMask)
+		action := string(namespaces.NetworkRuleIPActionAllow)
+		if v.Action != nil {
+			action = string(*v.Action)
 		}
+
+		ipMask := pointer.From(v.IPMask)
+
+		name, description := "", ""
+		if raw, ok := existingByIPMask[ipMask]; ok {
+			name = raw["name"].(string)
+			description = raw["description"].(string)
+		}
+
+		result = append(result, map[string]interface{}{
+			"ip_mask":     ipMask,
+			"action":      action,
+			"name":        name,
+			"description": description,
+		})
 	}
 
 	return result
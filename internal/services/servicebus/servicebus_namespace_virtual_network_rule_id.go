@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+)
+
+// serviceBusNamespaceVirtualNetworkRuleId identifies a single entry within a namespace's
+// NetworkRuleSet. Azure doesn't expose individual virtual network rules as their own ARM resource, so
+// this is modelled as the namespace's ID plus the rule's subnet ID rather than a generated `resourceids.Id`.
+type serviceBusNamespaceVirtualNetworkRuleId struct {
+	NamespaceId namespaces.NamespaceId
+	SubnetId    string
+}
+
+func (id serviceBusNamespaceVirtualNetworkRuleId) ID() string {
+	return fmt.Sprintf("%s|%s", id.NamespaceId.ID(), id.SubnetId)
+}
+
+func (id serviceBusNamespaceVirtualNetworkRuleId) String() string {
+	return fmt.Sprintf("Virtual Network Rule (%s, Subnet %q)", id.NamespaceId.String(), id.SubnetId)
+}
+
+func parseServiceBusNamespaceVirtualNetworkRuleID(input string) (*serviceBusNamespaceVirtualNetworkRuleId, error) {
+	segments := strings.SplitN(input, "|", 2)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return nil, fmt.Errorf("expected an ID in the format `{namespaceId}|{subnetId}` but got %q", input)
+	}
+
+	namespaceId, err := namespaces.ParseNamespaceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Service Bus Namespace ID: %+v", segments[0], err)
+	}
+
+	return &serviceBusNamespaceVirtualNetworkRuleId{
+		NamespaceId: *namespaceId,
+		SubnetId:    segments[1],
+	}, nil
+}
+
+func validateServiceBusNamespaceVirtualNetworkRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parseServiceBusNamespaceVirtualNetworkRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ServiceBusNamespaceVirtualNetworkRuleResource manages a single entry in a namespace's NetworkRuleSet,
+// so that ownership of individual subnets can be split across Terraform states/modules rather than
+// forcing one state to own the whole `network_rules` set on `azurerm_servicebus_namespace_network_rule_set`.
+// It should not be used on the same namespace as `azurerm_servicebus_namespace_network_rule_set`,
+// which always replaces the rule set wholesale and would fight this resource's merge-in-one-rule
+// behaviour.
+type ServiceBusNamespaceVirtualNetworkRuleResource struct{}
+
+var _ sdk.Resource = ServiceBusNamespaceVirtualNetworkRuleResource{}
+
+type ServiceBusNamespaceVirtualNetworkRuleModel struct {
+	NamespaceId                      string `tfschema:"namespace_id"`
+	SubnetId                         string `tfschema:"subnet_id"`
+	IgnoreMissingVnetServiceEndpoint bool   `tfschema:"ignore_missing_vnet_service_endpoint"`
+	Name                             string `tfschema:"name"`
+	Description                      string `tfschema:"description"`
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"ignore_missing_vnet_service_endpoint": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) ModelObject() interface{} {
+	return &ServiceBusNamespaceVirtualNetworkRuleModel{}
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) ResourceType() string {
+	return "azurerm_servicebus_namespace_virtual_network_rule"
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validateServiceBusNamespaceVirtualNetworkRuleID
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ServiceBusNamespaceVirtualNetworkRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			namespaceId, err := namespaces.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(namespaceId.NamespaceName, "azurerm_servicebus_namespace")
+			defer locks.UnlockByName(namespaceId.NamespaceName, "azurerm_servicebus_namespace")
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+
+			existing, err := client.GetNetworkRuleSet(ctx, *namespaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving network rule set for %s: %+v", namespaceId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving network rule set for %s: `model.properties` was nil", namespaceId)
+			}
+
+			props := existing.Model.Properties
+			vnetRules := make([]namespaces.NWRuleSetVirtualNetworkRules, 0)
+			if props.VirtualNetworkRules != nil {
+				for _, rule := range *props.VirtualNetworkRules {
+					if rule.Subnet != nil && rule.Subnet.Id == model.SubnetId {
+						return metadata.ResourceRequiresImport(r.ResourceType(), serviceBusNamespaceVirtualNetworkRuleId{NamespaceId: *namespaceId, SubnetId: model.SubnetId})
+					}
+					vnetRules = append(vnetRules, rule)
+				}
+			}
+
+			vnetRules = append(vnetRules, namespaces.NWRuleSetVirtualNetworkRules{
+				Subnet:                           &namespaces.Subnet{Id: model.SubnetId},
+				IgnoreMissingVnetServiceEndpoint: pointer.To(model.IgnoreMissingVnetServiceEndpoint),
+			})
+			props.VirtualNetworkRules = &vnetRules
+
+			if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, *namespaceId, namespaces.NetworkRuleSet{Properties: props}); err != nil {
+				return fmt.Errorf("creating Virtual Network Rule for subnet %q on %s: %+v", model.SubnetId, namespaceId, err)
+			}
+
+			id := serviceBusNamespaceVirtualNetworkRuleId{NamespaceId: *namespaceId, SubnetId: model.SubnetId}
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parseServiceBusNamespaceVirtualNetworkRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+			resp, err := client.GetNetworkRuleSet(ctx, id.NamespaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving network rule set for %s: %+v", id, err)
+			}
+
+			state := ServiceBusNamespaceVirtualNetworkRuleModel{
+				NamespaceId: id.NamespaceId.ID(),
+				SubnetId:    id.SubnetId,
+			}
+
+			found := false
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.VirtualNetworkRules != nil {
+				for _, rule := range *model.Properties.VirtualNetworkRules {
+					if rule.Subnet != nil && rule.Subnet.Id == id.SubnetId {
+						found = true
+						state.IgnoreMissingVnetServiceEndpoint = pointer.From(rule.IgnoreMissingVnetServiceEndpoint)
+						break
+					}
+				}
+			}
+			if !found {
+				return metadata.MarkAsGone(id)
+			}
+
+			// `name`/`description` have no server-side representation on a virtual network rule -
+			// they're never overwritten on Read, the same as `azurerm_servicebus_namespace_network_rule_set`.
+			state.Name = metadata.ResourceData.Get("name").(string)
+			state.Description = metadata.ResourceData.Get("description").(string)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServiceBusNamespaceVirtualNetworkRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parseServiceBusNamespaceVirtualNetworkRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.NamespaceId.NamespaceName, "azurerm_servicebus_namespace")
+			defer locks.UnlockByName(id.NamespaceId.NamespaceName, "azurerm_servicebus_namespace")
+
+			client := metadata.Client.ServiceBus.NamespacesClient
+
+			existing, err := client.GetNetworkRuleSet(ctx, id.NamespaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving network rule set for %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return nil
+			}
+
+			props := existing.Model.Properties
+			if props.VirtualNetworkRules == nil {
+				return nil
+			}
+
+			vnetRules := make([]namespaces.NWRuleSetVirtualNetworkRules, 0)
+			for _, rule := range *props.VirtualNetworkRules {
+				if rule.Subnet != nil && rule.Subnet.Id == id.SubnetId {
+					continue
+				}
+				vnetRules = append(vnetRules, rule)
+			}
+			props.VirtualNetworkRules = &vnetRules
+
+			if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, id.NamespaceId, namespaces.NetworkRuleSet{Properties: props}); err != nil {
+				return fmt.Errorf("removing Virtual Network Rule for subnet %q on %s: %+v", id.SubnetId, id.NamespaceId, err)
+			}
+
+			return nil
+		},
+	}
+}
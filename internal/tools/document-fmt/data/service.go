@@ -1,12 +1,8 @@
 package data
 
 import (
-	"errors"
 	"fmt"
-	"strings"
-	"unicode"
 
-	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/util"
 	"github.com/spf13/afero"
 )
@@ -19,48 +15,31 @@ type Service struct {
 	APIsByResource map[string][]API
 }
 
-func NewService(fs afero.Fs, providerDir string, providerServiceRegistration any, serviceName string) (*Service, error) {
-	labelFunc := func(s string) string {
-		return strings.ReplaceAll(strings.TrimPrefix(s, "service/"), "-", "")
-	}
-	nameFunc := func(s string) string {
-		var result []rune
-		for _, r := range s {
-			if unicode.IsLetter(r) {
-				result = append(result, r)
-			}
-		}
-
-		return strings.ToLower(string(result))
-	}
+// serviceDirectoryNamer is satisfied by any registration that defines a `ServiceDirectoryName()` method
+// returning the on-disk service directory name it lives under. The method is not part of the sdk
+// registration interfaces; NewService returns an error for a registration that does not define it.
+type serviceDirectoryNamer interface {
+	ServiceDirectoryName() string
+}
 
-	// Check if serviceName exists in ServiceFolderWorkaround
-	if n, ok := WorkaroundServiceNameToDirectory[serviceName]; ok {
-		serviceName = n
+func NewService(fs afero.Fs, providerDir string, providerServiceRegistration any, serviceName string) (*Service, error) {
+	namer, ok := providerServiceRegistration.(serviceDirectoryNamer)
+	if !ok {
+		return nil, fmt.Errorf("service registration for %q does not implement `ServiceDirectoryName()` - every service registration must provide a directory name", serviceName)
 	}
-	names := make([]string, 0)
 
-	// TODO: Add a method to the service registrations (untyped, typed, framework) that returns the service directory name
-	switch s := providerServiceRegistration.(type) {
-	case sdk.UntypedServiceRegistrationWithAGitHubLabel:
-		names = append(names, nameFunc(serviceName), labelFunc(s.AssociatedGitHubLabel()))
-	case sdk.TypedServiceRegistrationWithAGitHubLabel:
-		names = append(names, nameFunc(serviceName), labelFunc(s.AssociatedGitHubLabel()))
-	case sdk.UntypedServiceRegistration, sdk.FrameworkTypedServiceRegistration, sdk.TypedServiceRegistration:
-		names = append(names, nameFunc(serviceName))
-	default:
-		return nil, fmt.Errorf("unexpected service type `%T`", s)
+	name := namer.ServiceDirectoryName()
+	if name == "" {
+		return nil, fmt.Errorf("service registration for %q returned an empty `ServiceDirectoryName()`", serviceName)
 	}
 
-	for _, n := range names {
-		path := fmt.Sprintf(serviceDirPattern, providerDir, n)
-		if util.DirExists(fs, path) {
-			return &Service{
-				Name: n,
-				Path: path,
-			}, nil
-		}
+	path := fmt.Sprintf(serviceDirPattern, providerDir, name)
+	if !util.DirExists(fs, path) {
+		return nil, fmt.Errorf("no service directory found at %q for %q", path, serviceName)
 	}
 
-	return nil, errors.New("no service directory found")
+	return &Service{
+		Name: name,
+		Path: path,
+	}, nil
 }
@@ -35,16 +35,55 @@ func (p *ListByProjectCustomPager) NextPageLink() *odata.Link {
 	return p.NextLink
 }
 
+// ListByProjectOperationOptions carries the OData query options supported by the ARM `List by Project`
+// endpoint, so callers can push filtering (e.g. `contains(name,'vs2022')`) and field selection down to
+// the service instead of fetching every catalog/gallery image and filtering client-side, which matters
+// when a project's catalog carries hundreds of images.
+type ListByProjectOperationOptions struct {
+	Filter *string
+	Select *string
+	Top    *int64
+}
+
+func DefaultListByProjectOperationOptions() ListByProjectOperationOptions {
+	return ListByProjectOperationOptions{}
+}
+
+func (o ListByProjectOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	return &out
+}
+
+func (o ListByProjectOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Filter != nil {
+		out.Filter = *o.Filter
+	}
+	return &out
+}
+
+func (o ListByProjectOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	if o.Select != nil {
+		out.Append("$select", *o.Select)
+	}
+	if o.Top != nil {
+		out.Append("$top", fmt.Sprintf("%v", *o.Top))
+	}
+	return &out
+}
+
 // ListByProject ...
-func (c ImagesClient) ListByProject(ctx context.Context, id ProjectId) (result ListByProjectOperationResponse, err error) {
+func (c ImagesClient) ListByProject(ctx context.Context, id ProjectId, options ListByProjectOperationOptions) (result ListByProjectOperationResponse, err error) {
 	opts := client.RequestOptions{
 		ContentType: "application/json; charset=utf-8",
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
 		},
-		HttpMethod: http.MethodGet,
-		Pager:      &ListByProjectCustomPager{},
-		Path:       fmt.Sprintf("%s/images", id.ID()),
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Pager:         &ListByProjectCustomPager{},
+		Path:          fmt.Sprintf("%s/images", id.ID()),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -75,30 +114,40 @@ func (c ImagesClient) ListByProject(ctx context.Context, id ProjectId) (result L
 }
 
 // ListByProjectComplete retrieves all the results into a single object
-func (c ImagesClient) ListByProjectComplete(ctx context.Context, id ProjectId) (ListByProjectCompleteResult, error) {
-	return c.ListByProjectCompleteMatchingPredicate(ctx, id, ImageOperationPredicate{})
+func (c ImagesClient) ListByProjectComplete(ctx context.Context, id ProjectId, options ListByProjectOperationOptions) (ListByProjectCompleteResult, error) {
+	return c.ListByProjectCompleteMatchingPredicate(ctx, id, options, ImageOperationPredicate{})
 }
 
-// ListByProjectCompleteMatchingPredicate retrieves all the results and then applies the predicate
-func (c ImagesClient) ListByProjectCompleteMatchingPredicate(ctx context.Context, id ProjectId, predicate ImageOperationPredicate) (result ListByProjectCompleteResult, err error) {
+// ListByProjectCompleteMatchingPredicate retrieves all the results matching the predicate, short-
+// circuiting as soon as the iterator has produced them rather than buffering the whole catalog first.
+func (c ImagesClient) ListByProjectCompleteMatchingPredicate(ctx context.Context, id ProjectId, options ListByProjectOperationOptions, predicate ImageOperationPredicate) (result ListByProjectCompleteResult, err error) {
 	items := make([]Image, 0)
 
-	resp, err := c.ListByProject(ctx, id)
-	if err != nil {
-		result.LatestHttpResponse = resp.HttpResponse
-		err = fmt.Errorf("loading results: %+v", err)
-		return
-	}
-	if resp.Model != nil {
-		for _, v := range *resp.Model {
-			if predicate.Matches(v) {
-				items = append(items, v)
-			}
+	iter := c.ListByProjectIterator(ctx, id, options)
+	defer iter.Close()
+
+	for {
+		var item *Image
+		item, err = iter.Next(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading results: %+v", err)
+			return
+		}
+		if item == nil {
+			break
+		}
+		if predicate.Matches(*item) {
+			items = append(items, *item)
 		}
 	}
 
+	var latestHttpResponse *http.Response
+	if iter.latestResponse != nil {
+		latestHttpResponse = iter.latestResponse.Response
+	}
+
 	result = ListByProjectCompleteResult{
-		LatestHttpResponse: resp.HttpResponse,
+		LatestHttpResponse: latestHttpResponse,
 		Items:              items,
 	}
 	return
@@ -0,0 +1,117 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+// ImagesIterator streams the results of `List by Project` a page at a time, so large catalogs don't
+// have to be buffered into a single slice (and the caller's first result doesn't have to wait for the
+// last page to land). Call Next until it returns a nil Image with a nil error, then Close to release
+// the underlying *http.Response.
+type ImagesIterator struct {
+	client  ImagesClient
+	id      ProjectId
+	options ListByProjectOperationOptions
+
+	buffer         []Image
+	index          int
+	nextLink       *odata.Link
+	exhausted      bool
+	latestResponse *client.Response
+}
+
+// ListByProjectIterator returns an ImagesIterator that fetches pages on demand via Next.
+func (c ImagesClient) ListByProjectIterator(ctx context.Context, id ProjectId, options ListByProjectOperationOptions) *ImagesIterator {
+	return &ImagesIterator{
+		client:  c,
+		id:      id,
+		options: options,
+	}
+}
+
+// Next returns the next Image in the catalog, fetching the next page transparently when the local
+// buffer is drained. It returns (nil, nil) once every page has been consumed.
+func (it *ImagesIterator) Next(ctx context.Context) (*Image, error) {
+	for it.index >= len(it.buffer) {
+		if it.exhausted {
+			return nil, nil
+		}
+		if err := it.fetchNextPage(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	image := it.buffer[it.index]
+	it.index++
+	return &image, nil
+}
+
+// Close releases the underlying *http.Response associated with the most recently fetched page.
+func (it *ImagesIterator) Close() {
+	if it.latestResponse != nil && it.latestResponse.Response != nil && it.latestResponse.Response.Body != nil {
+		_ = it.latestResponse.Response.Body.Close()
+	}
+}
+
+func (it *ImagesIterator) fetchNextPage(ctx context.Context) error {
+	path := fmt.Sprintf("%s/images", it.id.ID())
+	if it.nextLink != nil {
+		resolved, err := it.nextLink.URL()
+		if err != nil {
+			return fmt.Errorf("parsing nextLink: %+v", err)
+		}
+		path = resolved.String()
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: it.options,
+		Path:          path,
+	}
+
+	req, err := it.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		it.latestResponse = resp
+	}
+	if err != nil {
+		return err
+	}
+
+	var values struct {
+		Values   *[]Image    `json:"value"`
+		NextLink *odata.Link `json:"nextLink"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return err
+	}
+
+	it.buffer = nil
+	it.index = 0
+	if values.Values != nil {
+		it.buffer = *values.Values
+	}
+
+	it.nextLink = values.NextLink
+	if it.nextLink == nil {
+		it.exhausted = true
+	}
+
+	return nil
+}
@@ -36,16 +36,63 @@ func (p *ListByResourceGroupCustomPager) NextPageLink() *odata.Link {
 	return p.NextLink
 }
 
+// ListByResourceGroupOperationOptions carries the OData query options supported by the ARM `List by
+// Resource Group` endpoint, so callers can push filtering (e.g. on `state`, `sku.name` or `tags`) and
+// field selection down to the service instead of paging every capacity and filtering client-side.
+type ListByResourceGroupOperationOptions struct {
+	Filter  *string
+	OrderBy *string
+	Skip    *int64
+	Top     *int64
+}
+
+func DefaultListByResourceGroupOperationOptions() ListByResourceGroupOperationOptions {
+	return ListByResourceGroupOperationOptions{}
+}
+
+func (o ListByResourceGroupOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	return &out
+}
+
+func (o ListByResourceGroupOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	out.Filter = pointerFromString(o.Filter)
+	if o.OrderBy != nil {
+		out.OrderBy = []odata.OrderBy{{Field: *o.OrderBy}}
+	}
+	return &out
+}
+
+func (o ListByResourceGroupOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	if o.Skip != nil {
+		out.Append("$skip", fmt.Sprintf("%v", *o.Skip))
+	}
+	if o.Top != nil {
+		out.Append("$top", fmt.Sprintf("%v", *o.Top))
+	}
+	return &out
+}
+
+func pointerFromString(input *string) string {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
+
 // ListByResourceGroup ...
-func (c CapacitiesClient) ListByResourceGroup(ctx context.Context, id commonids.ResourceGroupId) (result ListByResourceGroupOperationResponse, err error) {
+func (c CapacitiesClient) ListByResourceGroup(ctx context.Context, id commonids.ResourceGroupId, options ListByResourceGroupOperationOptions) (result ListByResourceGroupOperationResponse, err error) {
 	opts := client.RequestOptions{
 		ContentType: "application/json; charset=utf-8",
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
 		},
-		HttpMethod: http.MethodGet,
-		Pager:      &ListByResourceGroupCustomPager{},
-		Path:       fmt.Sprintf("%s/providers/Microsoft.PowerBIDedicated/capacities", id.ID()),
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Pager:         &ListByResourceGroupCustomPager{},
+		Path:          fmt.Sprintf("%s/providers/Microsoft.PowerBIDedicated/capacities", id.ID()),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -76,15 +123,15 @@ func (c CapacitiesClient) ListByResourceGroup(ctx context.Context, id commonids.
 }
 
 // ListByResourceGroupComplete retrieves all the results into a single object
-func (c CapacitiesClient) ListByResourceGroupComplete(ctx context.Context, id commonids.ResourceGroupId) (ListByResourceGroupCompleteResult, error) {
-	return c.ListByResourceGroupCompleteMatchingPredicate(ctx, id, DedicatedCapacityOperationPredicate{})
+func (c CapacitiesClient) ListByResourceGroupComplete(ctx context.Context, id commonids.ResourceGroupId, options ListByResourceGroupOperationOptions) (ListByResourceGroupCompleteResult, error) {
+	return c.ListByResourceGroupCompleteMatchingPredicate(ctx, id, options, DedicatedCapacityOperationPredicate{})
 }
 
 // ListByResourceGroupCompleteMatchingPredicate retrieves all the results and then applies the predicate
-func (c CapacitiesClient) ListByResourceGroupCompleteMatchingPredicate(ctx context.Context, id commonids.ResourceGroupId, predicate DedicatedCapacityOperationPredicate) (result ListByResourceGroupCompleteResult, err error) {
+func (c CapacitiesClient) ListByResourceGroupCompleteMatchingPredicate(ctx context.Context, id commonids.ResourceGroupId, options ListByResourceGroupOperationOptions, predicate DedicatedCapacityOperationPredicate) (result ListByResourceGroupCompleteResult, err error) {
 	items := make([]DedicatedCapacity, 0)
 
-	resp, err := c.ListByResourceGroup(ctx, id)
+	resp, err := c.ListByResourceGroup(ctx, id, options)
 	if err != nil {
 		result.LatestHttpResponse = resp.HttpResponse
 		err = fmt.Errorf("loading results: %+v", err)